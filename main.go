@@ -1,7 +1,9 @@
 package main
 
 import (
+	"io"
 	"log"
+	"os"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/tty2/kubic/pkg/config"
@@ -25,12 +27,25 @@ func run() error {
 
 	theme := themes.InitTheme(cfg.ThemePath)
 
-	k8sClient, err := k8s.New(cfg.KubeConfigPath, cfg.LogTail)
+	errorLog := io.Discard
+	if cfg.ErrorLogPath != "" {
+		f, err := os.OpenFile(cfg.ErrorLogPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+		defer f.Close() // nolint errcheck: nothing to do with the error here
+		errorLog = f
+	}
+	k8s.SetErrorLog(errorLog)
+
+	k8sClient, err := k8s.New(cfg.KubeConfigPath, cfg.Context, cfg.LogTail)
 	if err != nil {
 		return err
 	}
+	// port forwards outlive the tabs they are started from, they are closed on exit only
+	defer k8sClient.StopPortForwards()
 
-	gui, err := ui.New(k8sClient, theme)
+	gui, err := ui.New(k8sClient, theme, cfg)
 	if err != nil {
 		return err
 	}
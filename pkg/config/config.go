@@ -4,20 +4,20 @@ Package config keeps all config files.
 package config
 
 import (
-	"errors"
 	"fmt"
-	"path/filepath"
 
 	"github.com/jessevdk/go-flags"
-	"k8s.io/client-go/util/homedir"
 )
 
 // SearchConfig for application.
 // nolint lll // we need all of tags here. If we add CR here we'll catch structtag: *** key:"value" pairs not separated by spaces (govet)
 type Config struct {
-	KubeConfigPath string `short:"c" long:"config" env:"KUBIC_KUBERNETES_CONFIG_PATH" description:"kubernetes config file path"`
+	KubeConfigPath string `short:"c" long:"config" env:"KUBIC_KUBERNETES_CONFIG_PATH" description:"kubernetes config file path, KUBECONFIG or ~/.kube/config by default"`
+	Context        string `long:"context" env:"KUBIC_CONTEXT" description:"kubernetes config context, the current context by default"`
 	ThemePath      string `short:"t" long:"theme" env:"KUBIC_THEME_FILE_PATH" default:"./style.json" description:"theme file path"`
 	LogTail        int64  `short:"l" long:"log_tail" env:"KUBIC_LOG_TAIL_LINES" default:"100" description:"log tail lines"`
+	NoSecretReveal bool   `long:"no_secret_reveal" env:"KUBIC_NO_SECRET_REVEAL" description:"never show secret values"`
+	ErrorLogPath   string `long:"error_log" env:"KUBIC_ERROR_LOG_FILE" description:"file for errors of kubernetes client, they are discarded by default"`
 }
 
 // New creates a new config.
@@ -30,13 +30,5 @@ func New() (Config, error) {
 		return Config{}, fmt.Errorf("couldn't setup config: %w", err)
 	}
 
-	if config.KubeConfigPath == "" {
-		home := homedir.HomeDir()
-		if home == "" {
-			return Config{}, errors.New("setup file for kubernetes config or add it to ~/.kube/config")
-		}
-		config.KubeConfigPath = filepath.Join(home, ".kube", "config")
-	}
-
 	return config, nil
 }
@@ -0,0 +1,28 @@
+package domain
+
+// ChangeType is a kind of change received from the cluster watch.
+type ChangeType int
+
+const (
+	Added ChangeType = iota
+	Modified
+	Deleted
+	// Synced is sent once all the objects of the initial list are sent as `Added`.
+	Synced
+	// Failed is sent when the objects can't be listed, the watch retries it with backoff.
+	Failed
+)
+
+type PodChange struct {
+	Type ChangeType
+	Pod  Pod
+	// Err is set for `Failed` change.
+	Err error
+}
+
+type DeploymentChange struct {
+	Type       ChangeType
+	Deployment Deployment
+	// Err is set for `Failed` change.
+	Err error
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+type ConfigMap struct {
+	Name       string
+	Namespace  string
+	Data       map[string]string
+	BinaryData map[string][]byte
+	Labels     map[string]string
+	Age        string
+	Created    time.Time
+}
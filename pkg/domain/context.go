@@ -0,0 +1,11 @@
+package domain
+
+// Context is a kubeconfig context: the cluster with the user credentials and the default namespace.
+type Context struct {
+	Name      string
+	Cluster   string
+	User      string
+	Namespace string
+	// Current is true for the context the client is connected with.
+	Current bool
+}
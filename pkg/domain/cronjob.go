@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+type CronJob struct {
+	Name         string
+	Schedule     string
+	Suspend      bool
+	LastSchedule time.Time
+	Active       int
+	Age          string
+	Labels       map[string]string
+	Created      time.Time
+	Containers   []Container
+}
+
+type Job struct {
+	Name string
+	// CronJob is the name of the owner cron job. It's empty for jobs created directly.
+	CronJob     string
+	Completions string
+	Duration    string
+	Status      string
+	Age         string
+	Created     time.Time
+}
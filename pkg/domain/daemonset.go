@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+type DaemonSet struct {
+	Name           string
+	Desired        int
+	Scheduled      int
+	Misscheduled   int
+	Ready          int
+	UpToDate       int
+	Available      int
+	UpdateStrategy string
+	NodeSelector   map[string]string
+	Age            string
+	Labels         map[string]string
+	Created        time.Time
+	Containers     []Container
+}
@@ -3,8 +3,12 @@ package domain
 import "time"
 
 type Deployment struct {
-	Name              string
-	Ready             string
+	Name      string
+	Namespace string
+	Ready     string
+	// Replicas is the desired number of replicas.
+	Replicas          int
+	Paused            bool
 	UpdatedReplicas   int
 	AvailableReplicas int
 	ReadyReplicas     int
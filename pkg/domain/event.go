@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+const EventTypeWarning = "Warning"
+
+type Event struct {
+	Name    string
+	Type    string
+	Reason  string
+	Message string
+	// Object is the involved object in `kind/name` form.
+	Object   string
+	Count    int
+	LastSeen string
+	// LastTimestamp is the time the event was seen last time, it's used for ordering.
+	LastTimestamp time.Time
+	Source        string
+}
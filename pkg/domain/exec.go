@@ -0,0 +1,22 @@
+package domain
+
+import "io"
+
+// ExecOptions are options to run a command in the container.
+type ExecOptions struct {
+	// Container is a name of the container. Empty value means the default container of the pod.
+	Container string
+	Command   []string
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
+	// TTY allocates a terminal for the command, stderr is merged into stdout then.
+	TTY bool
+	// Resize receives the local terminal size changes, it's used with TTY.
+	Resize <-chan TerminalSize
+}
+
+type TerminalSize struct {
+	Width  uint16
+	Height uint16
+}
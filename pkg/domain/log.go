@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// LogOptions are options to request pod logs.
+type LogOptions struct {
+	// Follow keeps the stream open and sends new lines as they appear.
+	Follow bool
+	// Container is a name of the container. Empty value means the default container of the pod.
+	Container string
+	// Previous requests logs of the previous terminated container instance.
+	Previous bool
+	// SinceTime requests logs written after the time instead of the last lines. Zero value means not set.
+	SinceTime time.Time
+}
+
+// LogLine is a line of the container logs.
+type LogLine struct {
+	// Time is the time the line is written at, it's set by the container runtime. Zero value means unknown.
+	Time time.Time
+	Text string
+}
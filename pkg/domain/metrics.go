@@ -0,0 +1,32 @@
+package domain
+
+import "errors"
+
+// ErrMetricsUnavailable is returned when the cluster doesn't serve the metrics API, e.g. metrics-server isn't installed.
+var ErrMetricsUnavailable = errors.New("metrics unavailable")
+
+// Resources are CPU in millicores and memory in bytes. Zero means the value isn't set.
+type Resources struct {
+	CPU    int64
+	Memory int64
+}
+
+// PodMetrics is the current resource usage of the pod from the metrics API.
+// Usage is the sum of the containers usage.
+type PodMetrics struct {
+	Name       string
+	Namespace  string
+	Usage      Resources
+	Containers []ContainerMetrics
+}
+
+type ContainerMetrics struct {
+	Name  string
+	Usage Resources
+}
+
+// NodeMetrics is the current resource usage of the node from the metrics API.
+type NodeMetrics struct {
+	Name  string
+	Usage Resources
+}
@@ -1,7 +1,10 @@
 package domain
 
+import "time"
+
 type Namespace struct {
-	Name   string
-	Status string
-	Age    string
+	Name    string
+	Status  string
+	Age     string
+	Created time.Time
 }
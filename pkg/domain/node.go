@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+type Node struct {
+	Name          string
+	Roles         []string
+	Status        string
+	Version       string
+	Unschedulable bool
+	PodCount      int
+	Age           string
+	Created       time.Time
+	Labels        map[string]string
+	InternalIP    string
+	Conditions    []NodeCondition
+	// Taints are in `key=value:Effect` format.
+	Taints []string
+	// Capacity and Allocatable keep resource quantities by the resource name.
+	Capacity    map[string]string
+	Allocatable map[string]string
+	// AllocatableResources are allocatable CPU and memory, the usage of the node is compared with them.
+	AllocatableResources Resources
+}
+
+type NodeCondition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// DrainEvent is a progress event of the node drain. Pod is empty for the events related to the whole node.
+type DrainEvent struct {
+	Pod     string
+	Message string
+	Err     error
+}
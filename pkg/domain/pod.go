@@ -4,11 +4,12 @@ import "time"
 
 type Pod struct {
 	// list info
-	Name     string
-	Ready    string
-	Status   string
-	Restarts int
-	Age      string
+	Name      string
+	Namespace string
+	Ready     string
+	Status    string
+	Restarts  int
+	Age       string
 	// meta
 	Meta PodMeta
 	// spec
@@ -29,6 +30,7 @@ type PodSpec struct {
 	SchedulerName                 string
 	TerminationGracePeriodSeconds int64
 	Containers                    []Container
+	InitContainers                []Container
 }
 
 type PodStatusInfo struct {
@@ -38,9 +40,37 @@ type PodStatusInfo struct {
 	PodIP      string
 	PodIPs     []string
 	Conditions []string
+	// Ready is true if the pod `Ready` condition is true: only ready pods serve traffic of services.
+	Ready             bool
+	ContainerStatuses []ContainerStatus
+}
+
+type ContainerStatus struct {
+	Name            string
+	Ready           bool
+	RestartCount    int
+	LastTermination *ContainerTermination
+}
+
+// ContainerTermination keeps the details of the container termination.
+type ContainerTermination struct {
+	Reason     string
+	Message    string
+	ExitCode   int
+	Signal     int
+	StartedAt  time.Time
+	FinishedAt time.Time
 }
 
 type OwnerInfo struct {
 	Kind string
 	Name string
 }
+
+// DeleteOptions are options to delete a pod.
+type DeleteOptions struct {
+	// GracePeriod overrides the pod termination grace period in seconds. Nil keeps the pod default.
+	GracePeriod *int64
+	// Force deletes the pod immediately without waiting for kubelet to confirm the termination.
+	Force bool
+}
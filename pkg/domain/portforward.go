@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	PortForwardTargetPod     = "pod"
+	PortForwardTargetService = "svc"
+)
+
+const (
+	PortForwardStarting = "Starting"
+	PortForwardActive   = "Active"
+	PortForwardStopped  = "Stopped"
+	PortForwardFailed   = "Failed"
+)
+
+// PortForwardTarget is a pod or a service which port is forwarded to the local port.
+type PortForwardTarget struct {
+	// Kind is PortForwardTargetPod or PortForwardTargetService.
+	Kind      string
+	Namespace string
+	Name      string
+	// Port is the container port of the pod or the port of the service.
+	Port int
+}
+
+// String returns the target in the `kubectl port-forward` form: kind/name:port.
+func (t PortForwardTarget) String() string {
+	return t.Kind + "/" + t.Name + ":" + strconv.Itoa(t.Port)
+}
+
+// PortForward is a state of the background port forward.
+type PortForward struct {
+	ID int
+	// Context is the kubeconfig context the forward is started in.
+	Context string
+	Target  PortForwardTarget
+	// Pod is the pod which receives the traffic, it's resolved by the service selector for services.
+	Pod        string
+	LocalPort  int
+	RemotePort int
+	Status     string
+	// BytesIn is received from the pod, BytesOut is sent to the pod.
+	BytesIn  int64
+	BytesOut int64
+	Started  time.Time
+	Err      error
+}
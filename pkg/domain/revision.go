@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// Revision is a deployment rollout revision kept by the replica set.
+type Revision struct {
+	Number      int64
+	ReplicaSet  string
+	ChangeCause string
+	Images      []string
+	Replicas    int
+	Age         string
+	Created     time.Time
+	// Template is the pod template in YAML, it's used to compare revisions.
+	Template string
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+type Secret struct {
+	Name      string
+	Namespace string
+	Type      string
+	// Data keeps decoded secret values.
+	Data    map[string][]byte
+	Labels  map[string]string
+	Age     string
+	Created time.Time
+	// Certificates are parsed from `tls.crt` of `kubernetes.io/tls` secrets.
+	Certificates []Certificate
+	// Registries are parsed from `kubernetes.io/dockerconfigjson` and `kubernetes.io/dockercfg` secrets.
+	Registries []string
+}
+
+// Certificate keeps public info of x509 certificate.
+type Certificate struct {
+	Subject   string
+	Issuer    string
+	DNSNames  []string
+	IPs       []string
+	NotBefore time.Time
+	NotAfter  time.Time
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+type Service struct {
+	Name        string
+	Namespace   string
+	Type        string
+	ClusterIP   string
+	ExternalIPs []string
+	Ports       []ServicePort
+	Selector    map[string]string
+	Labels      map[string]string
+	Age         string
+	Created     time.Time
+}
+
+type ServicePort struct {
+	Name       string
+	Protocol   string
+	Port       int
+	TargetPort string
+	NodePort   int
+}
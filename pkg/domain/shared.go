@@ -6,9 +6,18 @@ type Container struct {
 	ImagePullPolicy        string
 	TerminationMessagePath string
 	ENVs                   []ContainerEnv
+	Ports                  []ContainerPort
+	Requests               Resources
+	Limits                 Resources
 }
 
 type ContainerEnv struct {
 	Name  string
 	Value string
 }
+
+type ContainerPort struct {
+	Name     string
+	Port     int
+	Protocol string
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+type StatefulSet struct {
+	Name            string
+	Ready           string
+	Replicas        int
+	ReadyReplicas   int
+	CurrentReplicas int
+	UpdatedReplicas int
+	ServiceName     string
+	UpdateStrategy  string
+	// Partition is the ordinal of the rolling update partition: only pods with ordinal >= partition are updated.
+	Partition           int
+	PodManagementPolicy string
+	Selector            map[string]string
+	Age                 string
+	Labels              map[string]string
+	Created             time.Time
+	Containers          []Container
+}
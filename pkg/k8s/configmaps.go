@@ -0,0 +1,38 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (c *Client) GetConfigMaps(ctx context.Context, namespace string) ([]domain.ConfigMap, error) {
+	apiResp, err := c.clientSet().CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	cms := make([]domain.ConfigMap, len(apiResp.Items))
+	for i := range apiResp.Items {
+		cms[i] = toDomainConfigMap(&apiResp.Items[i])
+	}
+
+	return cms, nil
+}
+
+func toDomainConfigMap(cm *corev1.ConfigMap) domain.ConfigMap {
+	age := time.Now().Unix() - cm.GetCreationTimestamp().Unix()
+
+	return domain.ConfigMap{
+		Name:       cm.Name,
+		Namespace:  cm.Namespace,
+		Data:       cm.Data,
+		BinaryData: cm.BinaryData,
+		Labels:     cm.Labels,
+		Age:        ageToString(age),
+		Created:    cm.CreationTimestamp.Time,
+	}
+}
@@ -0,0 +1,36 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_GetConfigMaps(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := Client{
+		set: fake.NewSimpleClientset(
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"},
+				Data:       map[string]string{"mode": "debug"},
+				BinaryData: map[string][]byte{"logo.png": {0x89, 0x50, 0x4e, 0x47}},
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kube-system"},
+			},
+		),
+	}
+
+	cms, err := client.GetConfigMaps(context.Background(), "default")
+	rq.NoError(err)
+	rq.Len(cms, 1)
+	rq.Equal("settings", cms[0].Name)
+	rq.Equal(map[string]string{"mode": "debug"}, cms[0].Data)
+	rq.Equal([]byte{0x89, 0x50, 0x4e, 0x47}, cms[0].BinaryData["logo.png"])
+}
@@ -0,0 +1,89 @@
+package k8s
+
+import (
+	"sort"
+
+	"github.com/tty2/kubic/pkg/domain"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// GetContexts returns all the contexts of the kubeconfig sorted by name.
+func (c *Client) GetContexts() ([]domain.Context, error) {
+	raw, err := newClientConfig(c.configPath, "").RawConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	current := c.CurrentContext()
+	contexts := make([]domain.Context, 0, len(raw.Contexts))
+	for name, ctx := range raw.Contexts {
+		contexts = append(contexts, domain.Context{
+			Name:      name,
+			Cluster:   ctx.Cluster,
+			User:      ctx.AuthInfo,
+			Namespace: ctx.Namespace,
+			Current:   name == current,
+		})
+	}
+
+	sort.Slice(contexts, func(i, j int) bool {
+		return contexts[i].Name < contexts[j].Name
+	})
+
+	return contexts, nil
+}
+
+// CurrentContext returns the name of the context the client is connected with.
+func (c *Client) CurrentContext() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.contextName
+}
+
+// SwitchContext connects the client to the cluster of the context. Empty name means the current context of the kubeconfig.
+// The client is left untouched on error. Running port forwards keep the connection they are started with.
+func (c *Client) SwitchContext(name string) error {
+	clientConfig := newClientConfig(c.configPath, name)
+
+	raw, err := clientConfig.RawConfig()
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = raw.CurrentContext
+	}
+
+	config, err := clientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set = clientSet
+	c.config = config
+	c.restClient = clientSet.CoreV1().RESTClient()
+	c.contextName = name
+
+	return nil
+}
+
+// newClientConfig loads the kubeconfig the same way kubectl does. The explicit path wins, otherwise the files
+// of KUBECONFIG are merged or ~/.kube/config is used. Inside a pod without a kubeconfig the in-cluster config is used.
+func newClientConfig(configPath, contextName string) clientcmd.ClientConfig {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = configPath
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		rules,
+		&clientcmd.ConfigOverrides{CurrentContext: contextName},
+	)
+}
@@ -0,0 +1,199 @@
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://dev.example.com
+- name: prod-cluster
+  cluster:
+    server: https://prod.example.com
+users:
+- name: dev-user
+  user:
+    token: dev-token
+- name: prod-user
+  user:
+    token: prod-token
+contexts:
+- name: prod
+  context:
+    cluster: prod-cluster
+    user: prod-user
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: dev-user
+    namespace: team
+`
+
+// testStagingKubeConfig is a separate per-cluster file without the current context.
+const testStagingKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: staging-cluster
+  cluster:
+    server: https://staging.example.com
+users:
+- name: staging-user
+  user:
+    token: staging-token
+contexts:
+- name: staging
+  context:
+    cluster: staging-cluster
+    user: staging-user
+`
+
+func writeTestKubeConfig(t *testing.T) string {
+	t.Helper()
+
+	return writeKubeConfig(t, testKubeConfig)
+}
+
+func writeKubeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func Test_New(t *testing.T) {
+	t.Parallel()
+
+	t.Run("current context", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client, err := New(writeTestKubeConfig(t), "", 100)
+		rq.NoError(err)
+		rq.Equal("dev", client.CurrentContext())
+		rq.Equal("https://dev.example.com", client.config.Host)
+	})
+
+	t.Run("context from flag", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client, err := New(writeTestKubeConfig(t), "prod", 100)
+		rq.NoError(err)
+		rq.Equal("prod", client.CurrentContext())
+		rq.Equal("https://prod.example.com", client.config.Host)
+	})
+
+	t.Run("unknown context", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := New(writeTestKubeConfig(t), "staging", 100)
+		require.Error(t, err)
+	})
+}
+
+// Test_New_KubeConfigEnv isn't parallel: it changes KUBECONFIG environment variable.
+func Test_New_KubeConfigEnv(t *testing.T) {
+	kubeConfig := strings.Join([]string{
+		writeTestKubeConfig(t),
+		writeKubeConfig(t, testStagingKubeConfig),
+	}, string(filepath.ListSeparator))
+	t.Setenv(clientcmd.RecommendedConfigPathEnvVar, kubeConfig)
+
+	t.Run("merged files", func(t *testing.T) {
+		rq := require.New(t)
+
+		client, err := New("", "", 100)
+		rq.NoError(err)
+		rq.Equal("dev", client.CurrentContext())
+
+		contexts, err := client.GetContexts()
+		rq.NoError(err)
+		rq.Len(contexts, 3)
+		rq.Equal("staging", contexts[2].Name)
+		rq.Equal("staging-cluster", contexts[2].Cluster)
+
+		rq.NoError(client.SwitchContext("staging"))
+		rq.Equal("https://staging.example.com", client.config.Host)
+	})
+
+	t.Run("explicit path wins", func(t *testing.T) {
+		rq := require.New(t)
+
+		client, err := New(writeKubeConfig(t, testStagingKubeConfig), "staging", 100)
+		rq.NoError(err)
+
+		contexts, err := client.GetContexts()
+		rq.NoError(err)
+		rq.Len(contexts, 1)
+		rq.Error(client.SwitchContext("dev"))
+	})
+}
+
+func Test_GetContexts(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client, err := New(writeTestKubeConfig(t), "", 100)
+	rq.NoError(err)
+
+	contexts, err := client.GetContexts()
+	rq.NoError(err)
+	rq.Len(contexts, 2)
+
+	rq.Equal("dev", contexts[0].Name)
+	rq.Equal("dev-cluster", contexts[0].Cluster)
+	rq.Equal("dev-user", contexts[0].User)
+	rq.Equal("team", contexts[0].Namespace)
+	rq.True(contexts[0].Current)
+
+	rq.Equal("prod", contexts[1].Name)
+	rq.Equal("prod-cluster", contexts[1].Cluster)
+	rq.False(contexts[1].Current)
+}
+
+func Test_SwitchContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client, err := New(writeTestKubeConfig(t), "", 100)
+		rq.NoError(err)
+		set := client.clientSet()
+
+		rq.NoError(client.SwitchContext("prod"))
+		rq.Equal("prod", client.CurrentContext())
+		rq.Equal("https://prod.example.com", client.config.Host)
+		rq.NotSame(set, client.clientSet())
+
+		contexts, err := client.GetContexts()
+		rq.NoError(err)
+		rq.False(contexts[0].Current)
+		rq.True(contexts[1].Current)
+	})
+
+	t.Run("unknown context", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client, err := New(writeTestKubeConfig(t), "", 100)
+		rq.NoError(err)
+
+		rq.Error(client.SwitchContext("staging"))
+		rq.Equal("dev", client.CurrentContext())
+		rq.Equal("https://dev.example.com", client.config.Host)
+	})
+}
@@ -0,0 +1,181 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	cronJobKind = "CronJob"
+	// manualJobAnnotation marks jobs created from cron job by user, the same way kubectl does.
+	manualJobAnnotation = "cronjob.kubernetes.io/instantiate"
+	jobStatusComplete   = "Complete"
+	jobStatusFailed     = "Failed"
+	jobStatusRunning    = "Running"
+)
+
+func (c *Client) GetCronJobs(ctx context.Context, namespace string) ([]domain.CronJob, error) {
+	apiResp, err := c.clientSet().BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	cronJobs := make([]domain.CronJob, len(apiResp.Items))
+	for i := range apiResp.Items {
+		cronJobs[i] = toDomainCronJob(&apiResp.Items[i])
+	}
+
+	return cronJobs, nil
+}
+
+// GetJobs returns jobs of the namespace, the newest first.
+func (c *Client) GetJobs(ctx context.Context, namespace string) ([]domain.Job, error) {
+	apiResp, err := c.clientSet().BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(apiResp.Items, func(i, j int) bool {
+		return apiResp.Items[j].CreationTimestamp.Before(&apiResp.Items[i].CreationTimestamp)
+	})
+
+	jobs := make([]domain.Job, len(apiResp.Items))
+	for i := range apiResp.Items {
+		jobs[i] = toDomainJob(&apiResp.Items[i])
+	}
+
+	return jobs, nil
+}
+
+// TriggerCronJob creates a job from the cron job template right now, like `kubectl create job --from=cronjob/name`.
+// It returns the name of the created job.
+func (c *Client) TriggerCronJob(ctx context.Context, namespace, name string) (string, error) {
+	cronJob, err := c.clientSet().BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	annotations := map[string]string{manualJobAnnotation: "manual"}
+	for k, v := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        manualJobName(name, time.Now()),
+			Namespace:   namespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind(cronJobKind)),
+			},
+		},
+		Spec: cronJob.Spec.JobTemplate.Spec,
+	}
+
+	created, err := c.clientSet().BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return created.Name, nil
+}
+
+// manualJobName returns the name of the job triggered by user: the cron job name with the suffix of the time.
+// Job name is the value of the `job-name` label of its pods, so the cron job name is truncated to fit the label value.
+func manualJobName(cronJob string, now time.Time) string {
+	suffix := "-manual-" + strconv.FormatInt(now.Unix(), 36)
+	if len(cronJob)+len(suffix) > validation.LabelValueMaxLength {
+		cronJob = cronJob[:validation.LabelValueMaxLength-len(suffix)]
+	}
+
+	return cronJob + suffix
+}
+
+// SetCronJobSuspend sets `spec.suspend` of the cron job.
+func (c *Client) SetCronJobSuspend(ctx context.Context, namespace, name string, suspend bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend))
+
+	_, err := c.clientSet().BatchV1().CronJobs(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+func toDomainCronJob(cj *batchv1.CronJob) domain.CronJob {
+	var cronJob domain.CronJob
+
+	cronJob.Name = cj.Name
+	cronJob.Schedule = cj.Spec.Schedule
+	cronJob.Suspend = cj.Spec.Suspend != nil && *cj.Spec.Suspend
+	if cj.Status.LastScheduleTime != nil {
+		cronJob.LastSchedule = cj.Status.LastScheduleTime.Time
+	}
+	cronJob.Active = len(cj.Status.Active)
+
+	age := time.Now().Unix() - cj.GetCreationTimestamp().Unix()
+	cronJob.Age = ageToString(age)
+
+	cronJob.Labels = cj.Labels
+	cronJob.Created = cj.CreationTimestamp.Time
+	cronJob.Containers = toDomainContainers(cj.Spec.JobTemplate.Spec.Template.Spec.Containers)
+
+	return cronJob
+}
+
+func toDomainJob(j *batchv1.Job) domain.Job {
+	var job domain.Job
+
+	job.Name = j.Name
+	for _, owner := range j.OwnerReferences {
+		if owner.Kind == cronJobKind {
+			job.CronJob = owner.Name
+		}
+	}
+
+	completions := int32(1) // default value if it's not set
+	if j.Spec.Completions != nil {
+		completions = *j.Spec.Completions
+	}
+	job.Completions = fmt.Sprintf("%d/%d", j.Status.Succeeded, completions)
+	job.Status = getJobStatus(j)
+
+	if j.Status.StartTime != nil {
+		finished := time.Now()
+		if j.Status.CompletionTime != nil {
+			finished = j.Status.CompletionTime.Time
+		}
+		job.Duration = ageToString(finished.Unix() - j.Status.StartTime.Unix())
+	}
+
+	age := time.Now().Unix() - j.GetCreationTimestamp().Unix()
+	job.Age = ageToString(age)
+	job.Created = j.CreationTimestamp.Time
+
+	return job
+}
+
+func getJobStatus(j *batchv1.Job) string {
+	for _, cond := range j.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return jobStatusComplete
+		case batchv1.JobFailed:
+			return jobStatusFailed
+		}
+	}
+
+	return jobStatusRunning
+}
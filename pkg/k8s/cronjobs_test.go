@@ -0,0 +1,193 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestCronJob() *batchv1.CronJob {
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default", UID: "backup-uid"},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 * * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "backup"}},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers:    []corev1.Container{{Name: "backup", Image: "backup:1.0"}},
+							RestartPolicy: corev1.RestartPolicyNever,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_GetCronJobs(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := Client{set: fake.NewSimpleClientset(newTestCronJob())}
+
+	cronJobs, err := client.GetCronJobs(context.Background(), "default")
+	rq.NoError(err)
+	rq.Len(cronJobs, 1)
+	rq.Equal("backup", cronJobs[0].Name)
+	rq.Equal("0 * * * *", cronJobs[0].Schedule)
+	rq.False(cronJobs[0].Suspend)
+	rq.True(cronJobs[0].LastSchedule.IsZero())
+	rq.Len(cronJobs[0].Containers, 1)
+}
+
+func Test_GetJobs(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	now := time.Now()
+	client := Client{
+		set: fake.NewSimpleClientset(
+			&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "backup-1",
+					Namespace:         "default",
+					CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+					OwnerReferences:   []metav1.OwnerReference{{Kind: "CronJob", Name: "backup"}},
+				},
+				Status: batchv1.JobStatus{
+					Succeeded:      1,
+					StartTime:      &metav1.Time{Time: now.Add(-2 * time.Hour)},
+					CompletionTime: &metav1.Time{Time: now.Add(-2*time.Hour + 90*time.Second)},
+					Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+					},
+				},
+			},
+			&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "migration",
+					Namespace:         "default",
+					CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+				},
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobFailed, Status: corev1.ConditionTrue},
+					},
+				},
+			},
+		),
+	}
+
+	jobs, err := client.GetJobs(context.Background(), "default")
+	rq.NoError(err)
+	rq.Len(jobs, 2)
+
+	// the newest first
+	rq.Equal("migration", jobs[0].Name)
+	rq.Equal("", jobs[0].CronJob)
+	rq.Equal("Failed", jobs[0].Status)
+	rq.Equal("0/1", jobs[0].Completions)
+
+	rq.Equal("backup-1", jobs[1].Name)
+	rq.Equal("backup", jobs[1].CronJob)
+	rq.Equal("Complete", jobs[1].Status)
+	rq.Equal("1/1", jobs[1].Completions)
+	rq.Equal("1m", jobs[1].Duration)
+}
+
+func Test_TriggerCronJob(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := Client{set: fake.NewSimpleClientset(newTestCronJob())}
+
+		name, err := client.TriggerCronJob(context.Background(), "default", "backup")
+		rq.NoError(err)
+		rq.Contains(name, "backup-manual-")
+
+		job, err := client.set.BatchV1().Jobs("default").Get(context.Background(), name, metav1.GetOptions{})
+		rq.NoError(err)
+		rq.Equal("manual", job.Annotations["cronjob.kubernetes.io/instantiate"])
+		rq.Equal(map[string]string{"app": "backup"}, job.Labels)
+		rq.Len(job.OwnerReferences, 1)
+		rq.Equal("CronJob", job.OwnerReferences[0].Kind)
+		rq.Equal("backup", job.OwnerReferences[0].Name)
+		rq.Equal("backup:1.0", job.Spec.Template.Spec.Containers[0].Image)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := Client{set: fake.NewSimpleClientset()}
+
+		_, err := client.TriggerCronJob(context.Background(), "default", "backup")
+		rq.Error(err)
+	})
+
+	t.Run("long name", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		// 52 characters is the max length of the cron job name
+		cronJob := newTestCronJob()
+		cronJob.Name = strings.Repeat("a", 52)
+		client := Client{set: fake.NewSimpleClientset(cronJob)}
+
+		name, err := client.TriggerCronJob(context.Background(), "default", cronJob.Name)
+		rq.NoError(err)
+		rq.LessOrEqual(len(name), 63)
+		rq.True(strings.HasPrefix(name, strings.Repeat("a", 40)))
+		rq.Contains(name, "-manual-")
+	})
+}
+
+func Test_manualJobName(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("short name", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "backup-manual-rfxl40", manualJobName("backup", now))
+	})
+
+	t.Run("unique by time", func(t *testing.T) {
+		t.Parallel()
+
+		require.NotEqual(t, manualJobName("backup", now), manualJobName("backup", now.Add(time.Second)))
+	})
+}
+
+func Test_SetCronJobSuspend(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := Client{set: fake.NewSimpleClientset(newTestCronJob())}
+
+	rq.NoError(client.SetCronJobSuspend(context.Background(), "default", "backup", true))
+
+	cronJob, err := client.set.BatchV1().CronJobs("default").Get(context.Background(), "backup", metav1.GetOptions{})
+	rq.NoError(err)
+	rq.NotNil(cronJob.Spec.Suspend)
+	rq.True(*cronJob.Spec.Suspend)
+
+	rq.NoError(client.SetCronJobSuspend(context.Background(), "default", "backup", false))
+
+	cronJob, err = client.set.BatchV1().CronJobs("default").Get(context.Background(), "backup", metav1.GetOptions{})
+	rq.NoError(err)
+	rq.False(*cronJob.Spec.Suspend)
+}
@@ -0,0 +1,44 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (c *Client) GetDaemonSets(ctx context.Context, namespace string) ([]domain.DaemonSet, error) {
+	apiResp, err := c.clientSet().AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]domain.DaemonSet, len(apiResp.Items))
+	for i := range apiResp.Items {
+		sets[i] = toDomainDaemonSet(&apiResp.Items[i])
+	}
+
+	return sets, nil
+}
+
+func toDomainDaemonSet(d *appsv1.DaemonSet) domain.DaemonSet {
+	age := time.Now().Unix() - d.GetCreationTimestamp().Unix()
+
+	return domain.DaemonSet{
+		Name:           d.Name,
+		Desired:        int(d.Status.DesiredNumberScheduled),
+		Scheduled:      int(d.Status.CurrentNumberScheduled),
+		Misscheduled:   int(d.Status.NumberMisscheduled),
+		Ready:          int(d.Status.NumberReady),
+		UpToDate:       int(d.Status.UpdatedNumberScheduled),
+		Available:      int(d.Status.NumberAvailable),
+		UpdateStrategy: string(d.Spec.UpdateStrategy.Type),
+		NodeSelector:   d.Spec.Template.Spec.NodeSelector,
+		Age:            ageToString(age),
+		Labels:         d.Labels,
+		Created:        d.CreationTimestamp.Time,
+		Containers:     toDomainContainers(d.Spec.Template.Spec.Containers),
+	}
+}
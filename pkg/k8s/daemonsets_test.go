@@ -0,0 +1,43 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_GetDaemonSets(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := Client{
+		set: fake.NewSimpleClientset(&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+			Status: appsv1.DaemonSetStatus{
+				DesiredNumberScheduled: 5,
+				CurrentNumberScheduled: 4,
+				NumberMisscheduled:     1,
+				NumberReady:            3,
+				UpdatedNumberScheduled: 4,
+				NumberAvailable:        3,
+			},
+		}),
+	}
+
+	sets, err := client.GetDaemonSets(context.Background(), "default")
+	rq.NoError(err)
+	rq.Len(sets, 1)
+
+	ds := sets[0]
+	rq.Equal("agent", ds.Name)
+	rq.Equal(5, ds.Desired)
+	rq.Equal(4, ds.Scheduled)
+	rq.Equal(1, ds.Misscheduled)
+	rq.Equal(3, ds.Ready)
+	rq.Equal(4, ds.UpToDate)
+	rq.Equal(3, ds.Available)
+}
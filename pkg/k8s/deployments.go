@@ -0,0 +1,250 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// restartedAtAnnotation is the pod template annotation set by `kubectl rollout restart`.
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+	revisionAnnotation    = "deployment.kubernetes.io/revision"
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+	// podTemplateHashLabel is added to the pod template of replica set by the deployment controller.
+	podTemplateHashLabel = "pod-template-hash"
+	deploymentKind       = "Deployment"
+	replicaSetKind       = "ReplicaSet"
+)
+
+// RestartDeployment triggers the rollout of new pods the same way as `kubectl rollout restart` does:
+// the pod template gets the annotation with the restart time.
+func (c *Client) RestartDeployment(ctx context.Context, namespace, name string) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		restartedAtAnnotation, time.Now().Format(time.RFC3339)))
+
+	_, err := c.clientSet().AppsV1().Deployments(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch,
+		metav1.PatchOptions{})
+
+	return err
+}
+
+// ScaleDeployment sets the desired number of replicas of the deployment.
+func (c *Client) ScaleDeployment(ctx context.Context, namespace, name string, replicas int) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas))
+
+	_, err := c.clientSet().AppsV1().Deployments(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// PauseDeployment pauses or resumes the rollout of the deployment.
+func (c *Client) PauseDeployment(ctx context.Context, namespace, name string, paused bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"paused":%t}}`, paused))
+
+	_, err := c.clientSet().AppsV1().Deployments(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// GetDeploymentRevisions returns rollout revisions of the deployment kept by its replica sets, the newest first,
+// and the resource version of the deployment they are read from.
+func (c *Client) GetDeploymentRevisions(ctx context.Context, namespace, name string) ([]domain.Revision, string, error) {
+	dep, err := c.clientSet().AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+
+	rss, err := c.getDeploymentReplicaSets(ctx, dep)
+	if err != nil {
+		return nil, "", err
+	}
+
+	revisions := make([]domain.Revision, 0, len(rss))
+	for i := range rss {
+		rev, err := toDomainRevision(&rss[i])
+		if err != nil {
+			return nil, "", err
+		}
+		revisions = append(revisions, rev)
+	}
+
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Number > revisions[j].Number
+	})
+
+	return revisions, dep.ResourceVersion, nil
+}
+
+// RollbackDeployment sets the pod template of the revision to the deployment, like `kubectl rollout undo` does.
+// Deployment controller creates a new revision with the number next to the last one.
+// The template is patched with the resource version of the deployment the revisions are read from
+// (returned by `GetDeploymentRevisions`), so the rollback fails with conflict
+// if the deployment is changed after the revisions are shown.
+func (c *Client) RollbackDeployment(ctx context.Context, namespace, name, resourceVersion string, revision int64) error {
+	dep, err := c.clientSet().AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	rss, err := c.getDeploymentReplicaSets(ctx, dep)
+	if err != nil {
+		return err
+	}
+
+	var template *corev1.PodTemplateSpec
+	for i := range rss {
+		if getRevisionNumber(&rss[i]) == revision {
+			template = rss[i].Spec.Template.DeepCopy()
+
+			break
+		}
+	}
+	if template == nil {
+		return fmt.Errorf("revision %d of deployment %s is not found", revision, name)
+	}
+	delete(template.Labels, podTemplateHashLabel)
+
+	data, err := rollbackPatch(resourceVersion, template)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.clientSet().AppsV1().Deployments(namespace).
+		Patch(ctx, name, types.StrategicMergePatchType, data, metav1.PatchOptions{})
+
+	return err
+}
+
+// rollbackPatch returns the strategic merge patch replacing the pod template of the deployment.
+// Resource version in the patch is the precondition checked by api server.
+func rollbackPatch(resourceVersion string, template *corev1.PodTemplateSpec) ([]byte, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+
+	var tmpl map[string]interface{}
+	if err := json.Unmarshal(data, &tmpl); err != nil {
+		return nil, err
+	}
+	// lists of the template like containers are merged by keys otherwise,
+	// the containers added after the revision would stay
+	tmpl["$patch"] = "replace"
+
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"resourceVersion": resourceVersion},
+		"spec":     map[string]interface{}{"template": tmpl},
+	})
+}
+
+// GetDeploymentPodOwners returns the replica sets of the deployment as owners of its pods.
+// Deployment doesn't own pods directly, the chain is Deployment → ReplicaSet → Pod.
+func (c *Client) GetDeploymentPodOwners(ctx context.Context, namespace, name string) ([]domain.OwnerInfo, error) {
+	dep, err := c.clientSet().AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	rss, err := c.getDeploymentReplicaSets(ctx, dep)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make([]domain.OwnerInfo, len(rss))
+	for i := range rss {
+		owners[i] = domain.OwnerInfo{
+			Kind: replicaSetKind,
+			Name: rss[i].Name,
+		}
+	}
+
+	return owners, nil
+}
+
+// GetReplicaSetController returns the controller of the replica set, it's the deployment which created it.
+// Empty owner is returned if the replica set has no controller.
+func (c *Client) GetReplicaSetController(ctx context.Context, namespace, name string) (domain.OwnerInfo, error) {
+	rs, err := c.clientSet().AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return domain.OwnerInfo{}, err
+	}
+
+	owner := metav1.GetControllerOf(rs)
+	if owner == nil {
+		return domain.OwnerInfo{}, nil
+	}
+
+	return domain.OwnerInfo{
+		Kind: owner.Kind,
+		Name: owner.Name,
+	}, nil
+}
+
+// getDeploymentReplicaSets returns replica sets controlled by the deployment.
+// Owner is compared by UID: replica sets of the deleted deployment with the same name are not its revisions.
+func (c *Client) getDeploymentReplicaSets(ctx context.Context, dep *appsv1.Deployment) ([]appsv1.ReplicaSet, error) {
+	apiResp, err := c.clientSet().AppsV1().ReplicaSets(dep.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	rss := make([]appsv1.ReplicaSet, 0, len(apiResp.Items))
+	for i := range apiResp.Items {
+		owner := metav1.GetControllerOf(&apiResp.Items[i])
+		if owner != nil && owner.Kind == deploymentKind && owner.UID == dep.UID {
+			rss = append(rss, apiResp.Items[i])
+		}
+	}
+
+	return rss, nil
+}
+
+func toDomainRevision(rs *appsv1.ReplicaSet) (domain.Revision, error) {
+	// the hash label is different for every revision, it's not a change of the template
+	template := rs.Spec.Template.DeepCopy()
+	delete(template.Labels, podTemplateHashLabel)
+
+	data, err := yaml.Marshal(template)
+	if err != nil {
+		return domain.Revision{}, err
+	}
+
+	images := make([]string, len(rs.Spec.Template.Spec.Containers))
+	for i := range rs.Spec.Template.Spec.Containers {
+		images[i] = rs.Spec.Template.Spec.Containers[i].Image
+	}
+
+	age := time.Now().Unix() - rs.GetCreationTimestamp().Unix()
+
+	return domain.Revision{
+		Number:      getRevisionNumber(rs),
+		ReplicaSet:  rs.Name,
+		ChangeCause: rs.Annotations[changeCauseAnnotation],
+		Images:      images,
+		Replicas:    int(rs.Status.Replicas),
+		Age:         ageToString(age),
+		Created:     rs.CreationTimestamp.Time,
+		Template:    string(data),
+	}, nil
+}
+
+// getRevisionNumber returns the revision of the replica set or 0 if it's not set.
+func getRevisionNumber(rs *appsv1.ReplicaSet) int64 {
+	v, err := strconv.ParseInt(rs.Annotations[revisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
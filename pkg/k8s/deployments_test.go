@@ -0,0 +1,289 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newTestDeploymentClient() *Client {
+	replicas := int32(1)
+
+	return &Client{
+		set: fake.NewSimpleClientset(&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		}),
+	}
+}
+
+func Test_GetDeployments(t *testing.T) {
+	t.Parallel()
+
+	client := Client{
+		set: fake.NewSimpleClientset(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team"}},
+		),
+	}
+
+	t.Run("namespace", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		deps, err := client.GetDeployments(context.Background(), "team")
+		rq.NoError(err)
+		rq.Len(deps, 1)
+		rq.Equal("app", deps[0].Name)
+		rq.Equal("team", deps[0].Namespace)
+	})
+
+	t.Run("all namespaces", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		deps, err := client.GetDeployments(context.Background(), metav1.NamespaceAll)
+		rq.NoError(err)
+		rq.Len(deps, 2)
+		rq.ElementsMatch([]string{"default", "team"}, []string{deps[0].Namespace, deps[1].Namespace})
+	})
+}
+
+func Test_RestartDeployment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := newTestDeploymentClient()
+		rq.NoError(client.RestartDeployment(context.Background(), "default", "app"))
+
+		dep, err := client.set.AppsV1().Deployments("default").Get(context.Background(), "app", metav1.GetOptions{})
+		rq.NoError(err)
+
+		restartedAt, err := time.Parse(time.RFC3339, dep.Spec.Template.Annotations[restartedAtAnnotation])
+		rq.NoError(err)
+		rq.WithinDuration(time.Now(), restartedAt, time.Minute)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		require.Error(t, newTestDeploymentClient().RestartDeployment(context.Background(), "default", "other"))
+	})
+}
+
+func Test_ScaleDeployment(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := newTestDeploymentClient()
+	rq.NoError(client.ScaleDeployment(context.Background(), "default", "app", 3))
+
+	deps, err := client.GetDeployments(context.Background(), "default")
+	rq.NoError(err)
+	rq.Len(deps, 1)
+	rq.Equal(3, deps[0].Replicas)
+}
+
+func Test_PauseDeployment(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := newTestDeploymentClient()
+
+	rq.NoError(client.PauseDeployment(context.Background(), "default", "app", true))
+	deps, err := client.GetDeployments(context.Background(), "default")
+	rq.NoError(err)
+	rq.True(deps[0].Paused)
+
+	rq.NoError(client.PauseDeployment(context.Background(), "default", "app", false))
+	deps, err = client.GetDeployments(context.Background(), "default")
+	rq.NoError(err)
+	rq.False(deps[0].Paused)
+}
+
+func newTestReplicaSet(dep *appsv1.Deployment, revision, image string) *appsv1.ReplicaSet {
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dep.Name + "-" + revision,
+			Namespace: dep.Namespace,
+			Annotations: map[string]string{
+				revisionAnnotation:    revision,
+				changeCauseAnnotation: "set image " + image,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(dep, appsv1.SchemeGroupVersion.WithKind(deploymentKind)),
+			},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": dep.Name, podTemplateHashLabel: revision},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "app", Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func newTestRevisionsClient() *Client {
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", UID: "app-uid", ResourceVersion: "10"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "app", Image: "app:10"}, {Name: "sidecar", Image: "sidecar:1"}},
+				},
+			},
+		},
+	}
+	other := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", UID: "other-uid"},
+	}
+	// the deployment with the same name deleted before the current one was created
+	deleted := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", UID: "deleted-uid"},
+	}
+
+	return &Client{
+		set: fake.NewSimpleClientset(
+			dep,
+			other,
+			newTestReplicaSet(dep, "2", "app:2"),
+			newTestReplicaSet(dep, "10", "app:10"),
+			newTestReplicaSet(dep, "1", "app:1"),
+			newTestReplicaSet(other, "1", "other:1"),
+			newTestReplicaSet(deleted, "3", "app:3"),
+		),
+	}
+}
+
+func Test_GetDeploymentRevisions(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	revisions, resourceVersion, err := newTestRevisionsClient().GetDeploymentRevisions(context.Background(), "default", "app")
+	rq.NoError(err)
+	rq.Equal("10", resourceVersion)
+	rq.Len(revisions, 3)
+
+	rq.Equal(int64(10), revisions[0].Number)
+	rq.Equal(int64(2), revisions[1].Number)
+	rq.Equal(int64(1), revisions[2].Number)
+
+	rq.Equal("app-10", revisions[0].ReplicaSet)
+	rq.Equal([]string{"app:10"}, revisions[0].Images)
+	rq.Equal("set image app:10", revisions[0].ChangeCause)
+	rq.Contains(revisions[0].Template, "image: app:10")
+	rq.NotContains(revisions[0].Template, podTemplateHashLabel)
+}
+
+func Test_GetDeploymentPodOwners(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	owners, err := newTestRevisionsClient().GetDeploymentPodOwners(context.Background(), "default", "app")
+	rq.NoError(err)
+	rq.Len(owners, 3)
+
+	names := make([]string, len(owners))
+	for i := range owners {
+		rq.Equal(replicaSetKind, owners[i].Kind)
+		names[i] = owners[i].Name
+	}
+	rq.ElementsMatch([]string{"app-1", "app-2", "app-10"}, names)
+}
+
+func Test_GetReplicaSetController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		owner, err := newTestRevisionsClient().GetReplicaSetController(context.Background(), "default", "app-2")
+		rq.NoError(err)
+		rq.Equal(deploymentKind, owner.Kind)
+		rq.Equal("app", owner.Name)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newTestRevisionsClient().GetReplicaSetController(context.Background(), "default", "app-5")
+		require.Error(t, err)
+	})
+}
+
+func Test_RollbackDeployment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := newTestRevisionsClient()
+		rq.NoError(client.RollbackDeployment(context.Background(), "default", "app", "10", 2))
+
+		dep, err := client.set.AppsV1().Deployments("default").Get(context.Background(), "app", metav1.GetOptions{})
+		rq.NoError(err)
+		rq.Equal("app:2", dep.Spec.Template.Spec.Containers[0].Image)
+		rq.Equal(map[string]string{"app": "app"}, dep.Spec.Template.Labels)
+		rq.Len(dep.Spec.Template.Spec.Containers, 1)
+
+		var patch *k8stesting.PatchActionImpl
+		for _, action := range client.set.(*fake.Clientset).Actions() {
+			if p, ok := action.(k8stesting.PatchActionImpl); ok {
+				patch = &p
+			}
+		}
+		rq.NotNil(patch)
+		rq.Equal(types.StrategicMergePatchType, patch.GetPatchType())
+		rq.Contains(string(patch.GetPatch()), `"resourceVersion":"10"`)
+	})
+
+	t.Run("resource version of loaded revisions", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		// the deployment is changed after the revisions are loaded with version 9,
+		// the patch keeps 9 for api server to reject it with conflict
+		client := newTestRevisionsClient()
+		rq.NoError(client.RollbackDeployment(context.Background(), "default", "app", "9", 2))
+
+		var patch *k8stesting.PatchActionImpl
+		for _, action := range client.set.(*fake.Clientset).Actions() {
+			if p, ok := action.(k8stesting.PatchActionImpl); ok {
+				patch = &p
+			}
+		}
+		rq.NotNil(patch)
+		rq.Contains(string(patch.GetPatch()), `"resourceVersion":"9"`)
+	})
+
+	t.Run("revision of deleted deployment", func(t *testing.T) {
+		t.Parallel()
+
+		err := newTestRevisionsClient().RollbackDeployment(context.Background(), "default", "app", "10", 3)
+		require.Error(t, err)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		t.Parallel()
+
+		err := newTestRevisionsClient().RollbackDeployment(context.Background(), "default", "app", "10", 5)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,111 @@
+package k8s
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const (
+	involvedObjectKindField = "involvedObject.kind"
+	involvedObjectNameField = "involvedObject.name"
+)
+
+// GetEvents returns events of the namespace, the last seen first.
+func (c *Client) GetEvents(ctx context.Context, namespace string) ([]domain.Event, error) {
+	apiResp, err := c.clientSet().CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainEvents(apiResp.Items), nil
+}
+
+// GetObjectEvents returns events of the object with the kind (e.g. `Pod`) and the name, the last seen first.
+func (c *Client) GetObjectEvents(ctx context.Context, namespace, kind, name string) ([]domain.Event, error) {
+	apiResp, err := c.clientSet().CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector(involvedObjectKindField, kind),
+			fields.OneTermEqualSelector(involvedObjectNameField, name),
+		).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// field selector is the server side optimization, the result is checked to not depend on it
+	events := make([]corev1.Event, 0, len(apiResp.Items))
+	for i := range apiResp.Items {
+		obj := apiResp.Items[i].InvolvedObject
+		if obj.Kind == kind && obj.Name == name {
+			events = append(events, apiResp.Items[i])
+		}
+	}
+
+	return toDomainEvents(events), nil
+}
+
+func toDomainEvents(ee []corev1.Event) []domain.Event {
+	events := make([]domain.Event, len(ee))
+	for i := range ee {
+		events[i] = toDomainEvent(&ee[i])
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp)
+	})
+
+	return events
+}
+
+func toDomainEvent(e *corev1.Event) domain.Event {
+	lastSeen := getEventLastSeen(e)
+
+	source := e.Source.Component
+	if source == "" {
+		source = e.ReportingController
+	}
+
+	return domain.Event{
+		Name:          e.Name,
+		Type:          e.Type,
+		Reason:        e.Reason,
+		Message:       e.Message,
+		Object:        e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name,
+		Count:         getEventCount(e),
+		LastSeen:      ageToString(time.Now().Unix() - lastSeen.Unix()),
+		LastTimestamp: lastSeen,
+		Source:        source,
+	}
+}
+
+// getEventLastSeen returns the last time the event was observed.
+// Events created by the new events API have no last timestamp, then series or event time is used.
+func getEventLastSeen(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case e.Series != nil && !e.Series.LastObservedTime.IsZero():
+		return e.Series.LastObservedTime.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+func getEventCount(e *corev1.Event) int {
+	switch {
+	case e.Count > 0:
+		return int(e.Count)
+	case e.Series != nil && e.Series.Count > 0:
+		return int(e.Series.Count)
+	default:
+		return 1
+	}
+}
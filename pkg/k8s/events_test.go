@@ -0,0 +1,98 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestEvent(name, kind, object string, lastSeen time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Name: object, Namespace: "default"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "FailedScheduling",
+		Message:        "0/3 nodes are available",
+		LastTimestamp:  metav1.NewTime(lastSeen),
+	}
+}
+
+func Test_GetEvents(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	now := time.Now()
+	client := Client{
+		set: fake.NewSimpleClientset(
+			newTestEvent("old", "Pod", "app-1", now.Add(-time.Hour)),
+			newTestEvent("new", "Deployment", "app", now.Add(-time.Minute)),
+		),
+	}
+
+	events, err := client.GetEvents(context.Background(), "default")
+	rq.NoError(err)
+	rq.Len(events, 2)
+	rq.Equal("new", events[0].Name)
+	rq.Equal("Deployment/app", events[0].Object)
+	rq.Equal("1m", events[0].LastSeen)
+	rq.Equal(1, events[0].Count)
+	rq.Equal("old", events[1].Name)
+}
+
+func Test_GetObjectEvents(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	now := time.Now()
+	client := Client{
+		set: fake.NewSimpleClientset(
+			newTestEvent("pod", "Pod", "app", now),
+			newTestEvent("deployment", "Deployment", "app", now),
+			newTestEvent("other", "Pod", "app-2", now),
+		),
+	}
+
+	events, err := client.GetObjectEvents(context.Background(), "default", "Pod", "app")
+	rq.NoError(err)
+	rq.Len(events, 1)
+	rq.Equal("pod", events[0].Name)
+	rq.Equal("Warning", events[0].Type)
+	rq.Equal("FailedScheduling", events[0].Reason)
+}
+
+func Test_getEventLastSeen(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	t.Run("last timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		e := corev1.Event{LastTimestamp: metav1.NewTime(now)}
+		require.Equal(t, now, getEventLastSeen(&e))
+	})
+
+	t.Run("series", func(t *testing.T) {
+		t.Parallel()
+
+		e := corev1.Event{
+			EventTime: metav1.NewMicroTime(now.Add(-time.Hour)),
+			Series:    &corev1.EventSeries{Count: 3, LastObservedTime: metav1.NewMicroTime(now)},
+		}
+		require.Equal(t, now, getEventLastSeen(&e))
+		require.Equal(t, 3, getEventCount(&e))
+	})
+
+	t.Run("event time", func(t *testing.T) {
+		t.Parallel()
+
+		e := corev1.Event{EventTime: metav1.NewMicroTime(now)}
+		require.Equal(t, now, getEventLastSeen(&e))
+		require.Equal(t, 1, getEventCount(&e))
+	})
+}
@@ -0,0 +1,71 @@
+package k8s
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// executorFactory creates an executor of the remote command for the request url.
+// It's a SPDY executor for the real cluster, the transport is replaced in tests.
+type executorFactory func(config *rest.Config, method string, u *url.URL) (remotecommand.Executor, error)
+
+// Exec runs the command in the container of the pod with the given streams and returns when the command exits.
+// Remote command stream can't be canceled, it's finished by the command itself or when stdin is closed.
+func (c *Client) Exec(namespace, name string, opts domain.ExecOptions) error {
+	config, restClient := c.restClients()
+	req := restClient.Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: opts.Container,
+			Command:   opts.Command,
+			Stdin:     opts.Stdin != nil,
+			Stdout:    opts.Stdout != nil,
+			Stderr:    opts.Stderr != nil && !opts.TTY,
+			TTY:       opts.TTY,
+		}, scheme.ParameterCodec)
+
+	executor, err := c.newExecutor(config, http.MethodPost, req.URL())
+	if err != nil {
+		return err
+	}
+
+	streamOpts := remotecommand.StreamOptions{
+		Stdin:  opts.Stdin,
+		Stdout: opts.Stdout,
+		Tty:    opts.TTY,
+	}
+	// stderr is merged into stdout by the terminal
+	if !opts.TTY {
+		streamOpts.Stderr = opts.Stderr
+	}
+	if opts.TTY && opts.Resize != nil {
+		streamOpts.TerminalSizeQueue = sizeQueue(opts.Resize)
+	}
+
+	return executor.Stream(streamOpts)
+}
+
+// sizeQueue passes the terminal size changes to the remote command.
+type sizeQueue <-chan domain.TerminalSize
+
+// Next returns the next terminal size or nil when the channel is closed, which stops the resizing.
+func (q sizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q
+	if !ok {
+		return nil
+	}
+
+	return &remotecommand.TerminalSize{
+		Width:  size.Width,
+		Height: size.Height,
+	}
+}
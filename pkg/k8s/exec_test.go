@@ -0,0 +1,137 @@
+package k8s
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// fakeExecutor stands in for the SPDY stream: it echoes stdin to stdout and records terminal sizes.
+type fakeExecutor struct {
+	mu    sync.Mutex
+	sizes []remotecommand.TerminalSize
+}
+
+func (e *fakeExecutor) Stream(opts remotecommand.StreamOptions) error {
+	if opts.TerminalSizeQueue != nil {
+		for size := opts.TerminalSizeQueue.Next(); size != nil; size = opts.TerminalSizeQueue.Next() {
+			e.mu.Lock()
+			e.sizes = append(e.sizes, *size)
+			e.mu.Unlock()
+		}
+	}
+
+	_, err := io.Copy(opts.Stdout, opts.Stdin)
+
+	return err
+}
+
+func newTestExecClient(t *testing.T, host string, newExecutor executorFactory) *Client {
+	t.Helper()
+
+	config := &rest.Config{Host: host}
+	set, err := kubernetes.NewForConfig(config)
+	require.NoError(t, err)
+
+	return &Client{
+		set:         set,
+		config:      config,
+		restClient:  set.CoreV1().RESTClient(),
+		newExecutor: newExecutor,
+	}
+}
+
+func Test_Exec(t *testing.T) {
+	t.Parallel()
+
+	t.Run("spdy request", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		requests := make(chan *http.Request, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests <- r
+			// stand-in refuses the protocol upgrade
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer srv.Close()
+
+		client := newTestExecClient(t, srv.URL, remotecommand.NewSPDYExecutor)
+
+		err := client.Exec("default", "app-1", domain.ExecOptions{
+			Container: "app",
+			Command:   []string{"/bin/sh"},
+			Stdin:     strings.NewReader(""),
+			Stdout:    io.Discard,
+			TTY:       true,
+		})
+		rq.Error(err)
+
+		r := <-requests
+		rq.Equal(http.MethodPost, r.Method)
+		rq.Equal("/api/v1/namespaces/default/pods/app-1/exec", r.URL.Path)
+		rq.Equal("app", r.URL.Query().Get("container"))
+		rq.Equal("/bin/sh", r.URL.Query().Get("command"))
+		rq.Equal("true", r.URL.Query().Get("stdin"))
+		rq.Equal("true", r.URL.Query().Get("tty"))
+		rq.Empty(r.URL.Query().Get("stderr"))
+		rq.NotEmpty(r.Header.Get("X-Stream-Protocol-Version"))
+	})
+
+	t.Run("streams and resize", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		executor := &fakeExecutor{}
+		var execURL *url.URL
+		client := newTestExecClient(t, "http://localhost", func(_ *rest.Config, method string, u *url.URL) (
+			remotecommand.Executor, error,
+		) {
+			execURL = u
+
+			return executor, nil
+		})
+
+		resize := make(chan domain.TerminalSize, 2)
+		resize <- domain.TerminalSize{Width: 80, Height: 24}
+		resize <- domain.TerminalSize{Width: 120, Height: 40}
+		close(resize)
+
+		var stdout bytes.Buffer
+		err := client.Exec("default", "app-1", domain.ExecOptions{
+			Command: []string{"sh"},
+			Stdin:   strings.NewReader("ls\n"),
+			Stdout:  &stdout,
+			TTY:     true,
+			Resize:  resize,
+		})
+		rq.NoError(err)
+		rq.Equal("ls\n", stdout.String())
+		rq.Equal([]remotecommand.TerminalSize{{Width: 80, Height: 24}, {Width: 120, Height: 40}}, executor.sizes)
+		rq.Equal("/api/v1/namespaces/default/pods/app-1/exec", execURL.Path)
+	})
+
+	t.Run("executor error", func(t *testing.T) {
+		t.Parallel()
+
+		client := newTestExecClient(t, "http://localhost", func(*rest.Config, string, *url.URL) (
+			remotecommand.Executor, error,
+		) {
+			return nil, errors.New("unsupported transport")
+		})
+
+		require.Error(t, client.Exec("default", "app-1", domain.ExecOptions{Command: []string{"sh"}}))
+	})
+}
@@ -1,41 +1,100 @@
 package k8s
 
 import (
+	"bufio"
 	"context"
+	"flag"
 	"fmt"
+	"io"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/tty2/kubic/pkg/domain"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
+	// exec credential plugins are built in, oidc auth provider has to be registered
+	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/klog/v2"
 )
 
+const podStatusTerminating = "Terminating"
+
 type Client struct {
-	set          *kubernetes.Clientset
+	// mu guards the connection to the cluster: it's replaced when the context is switched.
+	mu  sync.RWMutex
+	set kubernetes.Interface
+	// config and restClient are used to build requests for the streaming subresources like exec.
+	config     *rest.Config
+	restClient rest.Interface
+	// configPath is the explicit kubeconfig file, KUBECONFIG or ~/.kube/config is used if it's empty.
+	// contextName is the context the client is connected with.
+	configPath   string
+	contextName  string
 	logTailLines int64
+	newExecutor  executorFactory
+	newDialer    dialerFactory
+	forwards     portForwards
+	// evictionRetryDelay is the first delay of the eviction retry while the disruption budget doesn't allow it.
+	evictionRetryDelay time.Duration
 }
 
-func New(configPath string, logTailLines int64) (*Client, error) {
-	config, err := clientcmd.BuildConfigFromFlags("", configPath)
-	if err != nil {
-		return nil, err
+// New creates the client connected to the cluster of the kubeconfig context.
+// Empty context name means the current context of the kubeconfig.
+func New(configPath, contextName string, logTailLines int64) (*Client, error) {
+	c := &Client{
+		configPath:   configPath,
+		logTailLines: logTailLines,
+		newExecutor:  remotecommand.NewSPDYExecutor,
+		newDialer:    newSPDYDialer,
+
+		evictionRetryDelay: evictionRetryDelay,
 	}
 
-	clientSet, err := kubernetes.NewForConfig(config)
-	if err != nil {
+	if err := c.SwitchContext(contextName); err != nil {
 		return nil, err
 	}
 
-	return &Client{
-		set:          clientSet,
-		logTailLines: logTailLines,
-	}, nil
+	return c, nil
+}
+
+// SetErrorLog redirects the log of client-go to the writer.
+// client-go logs errors of the background streams (like port forward connections) to stderr
+// which breaks the terminal UI. Error handlers of client-go are kept: they rate-limit the repeated errors.
+func SetErrorLog(w io.Writer) {
+	flags := flag.NewFlagSet("klog", flag.ContinueOnError)
+	klog.InitFlags(flags)
+	// the flags are known, set can't fail
+	_ = flags.Set("logtostderr", "false")
+	_ = flags.Set("stderrthreshold", "FATAL")
+	// every message is written once instead of once per severity below it
+	_ = flags.Set("one_output", "true")
+
+	klog.SetOutput(w)
+}
+
+// clientSet returns the clientset of the current context.
+func (c *Client) clientSet() kubernetes.Interface {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.set
+}
+
+// restClients returns the config and the REST client of the current context for the streaming requests.
+func (c *Client) restClients() (*rest.Config, rest.Interface) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.config, c.restClient
 }
 
 func (c *Client) GetNamespaces(ctx context.Context) ([]domain.Namespace, error) {
-	apiResp, err := c.set.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	apiResp, err := c.clientSet().CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -47,97 +106,213 @@ func (c *Client) GetNamespaces(ctx context.Context) ([]domain.Namespace, error)
 
 		age := time.Now().Unix() - apiResp.Items[i].GetCreationTimestamp().Unix()
 		ns[i].Age = ageToString(age)
+		ns[i].Created = apiResp.Items[i].CreationTimestamp.Time
 	}
 
 	return ns, nil
 }
 
 func (c *Client) GetDeployments(ctx context.Context, namespace string) ([]domain.Deployment, error) {
-	apiResp, err := c.set.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	apiResp, err := c.clientSet().AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	deps := make([]domain.Deployment, len(apiResp.Items))
 	for i := range apiResp.Items {
-		deps[i].Name = apiResp.Items[i].Name
-		deps[i].Ready = fmt.Sprintf("%d/%d", apiResp.Items[i].Status.ReadyReplicas, apiResp.Items[i].Status.Replicas)
-		deps[i].UpdatedReplicas = int(apiResp.Items[i].Status.UpdatedReplicas)
-		deps[i].AvailableReplicas = int(apiResp.Items[i].Status.AvailableReplicas)
-		deps[i].ReadyReplicas = int(apiResp.Items[i].Status.ReadyReplicas)
-		deps[i].Tolerations = len(apiResp.Items[i].Spec.Template.Spec.Tolerations)
-
-		age := time.Now().Unix() - apiResp.Items[i].GetCreationTimestamp().Unix()
-		deps[i].Age = ageToString(age)
-
-		deps[i].Labels = apiResp.Items[i].Labels
-		deps[i].Created = apiResp.Items[i].ObjectMeta.CreationTimestamp.Time
-
-		// populate meta
-		deps[i].Meta.Strategy = string(apiResp.Items[i].Spec.Strategy.Type)
-		deps[i].Meta.DNSPolicy = string(apiResp.Items[i].Spec.Template.Spec.DNSPolicy)
-		deps[i].Meta.RestartPolicy = string(apiResp.Items[i].Spec.Template.Spec.RestartPolicy)
-		deps[i].Meta.SchedulerName = apiResp.Items[i].Spec.Template.Spec.SchedulerName
-		deps[i].Meta.TerminationGracePeriodSeconds = *apiResp.Items[i].Spec.Template.Spec.TerminationGracePeriodSeconds
-		deps[i].Meta.Containers = toDomainContainers(apiResp.Items[i].Spec.Template.Spec.Containers)
+		deps[i] = toDomainDeployment(&apiResp.Items[i])
 	}
 
 	return deps, nil
 }
 
 func (c *Client) GetPods(ctx context.Context, namespace string) ([]domain.Pod, error) {
-	apiResp, err := c.set.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	apiResp, err := c.clientSet().CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	pods := make([]domain.Pod, len(apiResp.Items))
 	for i := range apiResp.Items {
-		pods[i].Name = apiResp.Items[i].Name
-		pods[i].Ready = getReadyOfListCont(apiResp.Items[i].Status.ContainerStatuses)
-		pods[i].Status = string(apiResp.Items[i].Status.Phase)
-		pods[i].Restarts = getRestartsCount(apiResp.Items[i].Status.ContainerStatuses)
+		pods[i] = toDomainPod(&apiResp.Items[i])
+	}
 
-		age := time.Now().Unix() - apiResp.Items[i].GetCreationTimestamp().Unix()
-		pods[i].Age = ageToString(age)
+	return pods, nil
+}
+
+// StreamPodLogs opens logs stream of the pod and sends it line by line to the returned channel.
+// The channel is closed when the stream is over or ctx is done.
+func (c *Client) StreamPodLogs(ctx context.Context, namespace, name string, opts domain.LogOptions) (<-chan domain.LogLine, error) {
+	stream, err := c.clientSet().CoreV1().
+		Pods(namespace).
+		GetLogs(name, toPodLogOptions(opts, c.logTailLines)).
+		Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		// populate meta
-		pods[i].Meta.Created = apiResp.Items[i].CreationTimestamp.Time
-		pods[i].Meta.Labels = apiResp.Items[i].Labels
-		pods[i].Meta.Owners = toDomainOwnerInfoList(apiResp.Items[i].OwnerReferences)
+	lines := make(chan domain.LogLine)
+
+	go func() {
+		defer close(lines)
+		defer stream.Close()
+
+		reader := bufio.NewReader(stream)
+		for {
+			line, err := reader.ReadString('\n')
+			if line != "" {
+				select {
+				case lines <- parseLogLine(strings.TrimSuffix(line, "\n")):
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
 
-		// populate spec
-		pods[i].Spec.DNSPolicy = string(apiResp.Items[i].Spec.DNSPolicy)
-		pods[i].Spec.RestartPolicy = string(apiResp.Items[i].Spec.RestartPolicy)
-		pods[i].Spec.SchedulerName = apiResp.Items[i].Spec.SchedulerName
-		pods[i].Spec.TerminationGracePeriodSeconds = *apiResp.Items[i].Spec.TerminationGracePeriodSeconds
-		pods[i].Spec.Containers = toDomainContainers(apiResp.Items[i].Spec.Containers)
+	return lines, nil
+}
 
-		// populate status info
-		pods[i].StatusInfo.Phase = string(apiResp.Items[i].Status.Phase)
-		pods[i].StatusInfo.QosClass = string(apiResp.Items[i].Status.QOSClass)
-		pods[i].StatusInfo.HostIP = apiResp.Items[i].Status.HostIP
-		pods[i].StatusInfo.PodIP = apiResp.Items[i].Status.PodIP
-		pods[i].StatusInfo.PodIPs = podIPsToDomainList(apiResp.Items[i].Status.PodIPs)
-		pods[i].StatusInfo.Conditions = conditionsToDomainList(apiResp.Items[i].Status.Conditions)
+// parseLogLine splits the timestamp added by the API server from the line.
+// The line is kept as is if it doesn't start with the timestamp.
+func parseLogLine(line string) domain.LogLine {
+	if ts, text, ok := strings.Cut(line, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			return domain.LogLine{Time: t, Text: text}
+		}
 	}
 
-	return pods, nil
+	return domain.LogLine{Text: line}
 }
 
-func (c *Client) PodsLog(ctx context.Context, namespace, name string) []byte {
-	data, err := c.set.CoreV1().
-		Pods(namespace).
-		GetLogs(name, &corev1.PodLogOptions{
-			TailLines: &c.logTailLines,
-		}).
-		Do(ctx).
-		Raw()
-	if err != nil {
-		return []byte("")
+// toPodLogOptions requests the last tail lines, or all the lines written after the since time if it's set.
+// Lines are requested with timestamps: the time of the last line is the since time of the reopened stream.
+func toPodLogOptions(opts domain.LogOptions, tailLines int64) *corev1.PodLogOptions {
+	logOpts := &corev1.PodLogOptions{
+		Container:  opts.Container,
+		Follow:     opts.Follow,
+		Previous:   opts.Previous,
+		Timestamps: true,
+	}
+	if opts.SinceTime.IsZero() {
+		logOpts.TailLines = &tailLines
+	} else {
+		logOpts.SinceTime = &metav1.Time{Time: opts.SinceTime}
 	}
 
-	return data
+	return logOpts
+}
+
+// DeletePod deletes the pod. Force deletion sets zero grace period, the pod object is removed immediately
+// without waiting for kubelet to confirm the containers are stopped.
+// Zero grace period means the force deletion for the API server, so without force it's sent as 1 like kubectl does.
+func (c *Client) DeletePod(ctx context.Context, namespace, name string, opts domain.DeleteOptions) error {
+	deleteOpts := metav1.DeleteOptions{
+		GracePeriodSeconds: opts.GracePeriod,
+	}
+	switch {
+	case opts.Force:
+		var zero int64
+		deleteOpts.GracePeriodSeconds = &zero
+	case opts.GracePeriod != nil && *opts.GracePeriod == 0:
+		minGrace := int64(1)
+		deleteOpts.GracePeriodSeconds = &minGrace
+	}
+
+	return c.clientSet().CoreV1().Pods(namespace).Delete(ctx, name, deleteOpts)
+}
+
+func toDomainDeployment(d *appsv1.Deployment) domain.Deployment {
+	var dep domain.Deployment
+
+	dep.Name = d.Name
+	dep.Namespace = d.Namespace
+	dep.Ready = fmt.Sprintf("%d/%d", d.Status.ReadyReplicas, d.Status.Replicas)
+	dep.UpdatedReplicas = int(d.Status.UpdatedReplicas)
+	dep.AvailableReplicas = int(d.Status.AvailableReplicas)
+	dep.ReadyReplicas = int(d.Status.ReadyReplicas)
+	dep.Tolerations = len(d.Spec.Template.Spec.Tolerations)
+	dep.Paused = d.Spec.Paused
+	if d.Spec.Replicas != nil {
+		dep.Replicas = int(*d.Spec.Replicas)
+	}
+
+	age := time.Now().Unix() - d.GetCreationTimestamp().Unix()
+	dep.Age = ageToString(age)
+
+	dep.Labels = d.Labels
+	dep.Created = d.ObjectMeta.CreationTimestamp.Time
+
+	// populate meta
+	dep.Meta.Strategy = string(d.Spec.Strategy.Type)
+	dep.Meta.DNSPolicy = string(d.Spec.Template.Spec.DNSPolicy)
+	dep.Meta.RestartPolicy = string(d.Spec.Template.Spec.RestartPolicy)
+	dep.Meta.SchedulerName = d.Spec.Template.Spec.SchedulerName
+	dep.Meta.TerminationGracePeriodSeconds = int64Value(d.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	dep.Meta.Containers = toDomainContainers(d.Spec.Template.Spec.Containers)
+
+	return dep
+}
+
+func toDomainPod(p *corev1.Pod) domain.Pod {
+	var pod domain.Pod
+
+	pod.Name = p.Name
+	pod.Namespace = p.Namespace
+	pod.Ready = getReadyOfListCont(p.Status.ContainerStatuses)
+	pod.Status = getPodStatus(p)
+	pod.Restarts = getRestartsCount(p.Status.ContainerStatuses)
+
+	age := time.Now().Unix() - p.GetCreationTimestamp().Unix()
+	pod.Age = ageToString(age)
+
+	// populate meta
+	pod.Meta.Created = p.CreationTimestamp.Time
+	pod.Meta.Labels = p.Labels
+	pod.Meta.Owners = toDomainOwnerInfoList(p.OwnerReferences)
+
+	// populate spec
+	pod.Spec.DNSPolicy = string(p.Spec.DNSPolicy)
+	pod.Spec.RestartPolicy = string(p.Spec.RestartPolicy)
+	pod.Spec.SchedulerName = p.Spec.SchedulerName
+	pod.Spec.TerminationGracePeriodSeconds = int64Value(p.Spec.TerminationGracePeriodSeconds)
+	pod.Spec.Containers = toDomainContainers(p.Spec.Containers)
+	pod.Spec.InitContainers = toDomainContainers(p.Spec.InitContainers)
+
+	// populate status info
+	pod.StatusInfo.Phase = string(p.Status.Phase)
+	pod.StatusInfo.QosClass = string(p.Status.QOSClass)
+	pod.StatusInfo.HostIP = p.Status.HostIP
+	pod.StatusInfo.PodIP = p.Status.PodIP
+	pod.StatusInfo.PodIPs = podIPsToDomainList(p.Status.PodIPs)
+	pod.StatusInfo.Conditions = conditionsToDomainList(p.Status.Conditions)
+	pod.StatusInfo.Ready = isPodReady(p.Status.Conditions)
+	pod.StatusInfo.ContainerStatuses = append(
+		toDomainContainerStatuses(p.Status.ContainerStatuses),
+		toDomainContainerStatuses(p.Status.InitContainerStatuses)...,
+	)
+
+	return pod
+}
+
+// getPodStatus returns the pod phase or `Terminating` for the pod which is being deleted, the same as kubectl shows.
+func getPodStatus(p *corev1.Pod) string {
+	if p.DeletionTimestamp != nil {
+		return podStatusTerminating
+	}
+
+	return string(p.Status.Phase)
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+
+	return *v
 }
 
 // nolint gomnd: numbers are obvious here
@@ -200,11 +375,25 @@ func toDomainContainers(cc []corev1.Container) []domain.Container {
 		domainContainers[i].ImagePullPolicy = string(cc[i].ImagePullPolicy)
 		domainContainers[i].TerminationMessagePath = cc[i].TerminationMessagePath
 		domainContainers[i].ENVs = getEnvs(cc[i].Env)
+		domainContainers[i].Ports = getContainerPorts(cc[i].Ports)
+		domainContainers[i].Requests = toDomainResources(cc[i].Resources.Requests)
+		domainContainers[i].Limits = toDomainResources(cc[i].Resources.Limits)
 	}
 
 	return domainContainers
 }
 
+func getContainerPorts(pp []corev1.ContainerPort) []domain.ContainerPort {
+	ports := make([]domain.ContainerPort, len(pp))
+	for i := range pp {
+		ports[i].Name = pp[i].Name
+		ports[i].Port = int(pp[i].ContainerPort)
+		ports[i].Protocol = string(pp[i].Protocol)
+	}
+
+	return ports
+}
+
 func toDomainOwnerInfoList(oref []metav1.OwnerReference) []domain.OwnerInfo {
 	resp := make([]domain.OwnerInfo, len(oref))
 	for i := range oref {
@@ -224,6 +413,40 @@ func podIPsToDomainList(ips []corev1.PodIP) []string {
 	return resp
 }
 
+func toDomainContainerStatuses(ss []corev1.ContainerStatus) []domain.ContainerStatus {
+	resp := make([]domain.ContainerStatus, len(ss))
+	for i := range ss {
+		resp[i].Name = ss[i].Name
+		resp[i].Ready = ss[i].Ready
+		resp[i].RestartCount = int(ss[i].RestartCount)
+
+		t := ss[i].LastTerminationState.Terminated
+		if t == nil {
+			continue
+		}
+		resp[i].LastTermination = &domain.ContainerTermination{
+			Reason:     t.Reason,
+			Message:    t.Message,
+			ExitCode:   int(t.ExitCode),
+			Signal:     int(t.Signal),
+			StartedAt:  t.StartedAt.Time,
+			FinishedAt: t.FinishedAt.Time,
+		}
+	}
+
+	return resp
+}
+
+func isPodReady(conds []corev1.PodCondition) bool {
+	for i := range conds {
+		if conds[i].Type == corev1.PodReady {
+			return conds[i].Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
 func conditionsToDomainList(conds []corev1.PodCondition) []string {
 	resp := make([]string, len(conds))
 	for i := range conds {
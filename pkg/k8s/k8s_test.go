@@ -1,10 +1,16 @@
 package k8s
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func Test_ageToString(t *testing.T) {
@@ -138,6 +144,37 @@ func Test_getReadyOfListCont(t *testing.T) {
 	})
 }
 
+func Test_isPodReady(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	t.Run("ready", func(t *testing.T) {
+		t.Parallel()
+
+		cc := []corev1.PodCondition{
+			{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+			{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+		}
+
+		rq.True(isPodReady(cc))
+	})
+	t.Run("not ready", func(t *testing.T) {
+		t.Parallel()
+
+		cc := []corev1.PodCondition{
+			{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+			{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+		}
+
+		rq.False(isPodReady(cc))
+	})
+	t.Run("no condition", func(t *testing.T) {
+		t.Parallel()
+
+		rq.False(isPodReady(nil))
+	})
+}
+
 func Test_getRestartsCount(t *testing.T) {
 	t.Parallel()
 	rq := require.New(t)
@@ -196,3 +233,206 @@ func Test_getRestartsCount(t *testing.T) {
 		rq.Equal(0, getRestartsCount(ss))
 	})
 }
+
+func Test_StreamPodLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := Client{
+			set: fake.NewSimpleClientset(&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "app-1", Namespace: "default"},
+			}),
+		}
+
+		lines, err := client.StreamPodLogs(context.Background(), "default", "app-1", domain.LogOptions{Follow: true})
+		rq.NoError(err)
+
+		// fake client always returns `fake logs` as a body
+		rq.Equal(domain.LogLine{Text: "fake logs"}, receive(t, lines))
+
+		_, ok := <-lines
+		rq.False(ok)
+	})
+}
+
+func Test_parseLogLine(t *testing.T) {
+	t.Parallel()
+
+	t.Run("timestamp", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		line := parseLogLine("2022-08-01T10:00:00.123456789Z GET /health 200")
+		rq.Equal("GET /health 200", line.Text)
+		rq.True(time.Date(2022, 8, 1, 10, 0, 0, 123456789, time.UTC).Equal(line.Time))
+	})
+
+	t.Run("no timestamp", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		line := parseLogLine("GET /health 200")
+		rq.Equal("GET /health 200", line.Text)
+		rq.True(line.Time.IsZero())
+	})
+}
+
+func Test_toPodLogOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tail", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		opts := toPodLogOptions(domain.LogOptions{Follow: true, Container: "app"}, 100)
+
+		rq.True(opts.Timestamps)
+		rq.True(opts.Follow)
+		rq.Equal("app", opts.Container)
+		rq.NotNil(opts.TailLines)
+		rq.Equal(int64(100), *opts.TailLines)
+		rq.Nil(opts.SinceTime)
+	})
+
+	t.Run("since time", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		since := time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC)
+		opts := toPodLogOptions(domain.LogOptions{Follow: true, SinceTime: since}, 100)
+
+		rq.Nil(opts.TailLines)
+		rq.NotNil(opts.SinceTime)
+		rq.True(since.Equal(opts.SinceTime.Time))
+	})
+}
+
+func Test_toDomainContainerStatuses(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	t.Run("with last termination", func(t *testing.T) {
+		t.Parallel()
+
+		finished := time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC)
+		ss := []corev1.ContainerStatus{
+			{
+				Name:         "app",
+				RestartCount: 3,
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						Reason:     "OOMKilled",
+						ExitCode:   137,
+						FinishedAt: metav1.NewTime(finished),
+					},
+				},
+			},
+			{
+				Name:  "sidecar",
+				Ready: true,
+			},
+		}
+
+		res := toDomainContainerStatuses(ss)
+		rq.Len(res, 2)
+
+		rq.Equal("app", res[0].Name)
+		rq.Equal(3, res[0].RestartCount)
+		rq.NotNil(res[0].LastTermination)
+		rq.Equal("OOMKilled", res[0].LastTermination.Reason)
+		rq.Equal(137, res[0].LastTermination.ExitCode)
+		rq.Equal(finished, res[0].LastTermination.FinishedAt)
+
+		rq.Equal("sidecar", res[1].Name)
+		rq.True(res[1].Ready)
+		rq.Nil(res[1].LastTermination)
+	})
+}
+
+func Test_DeletePod(t *testing.T) {
+	t.Parallel()
+
+	newClient := func() (*Client, *fake.Clientset) {
+		set := fake.NewSimpleClientset(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-1", Namespace: "default"},
+		})
+
+		return &Client{set: set}, set
+	}
+
+	deleteOptions := func(set *fake.Clientset) metav1.DeleteOptions {
+		for _, a := range set.Actions() {
+			if d, ok := a.(k8stesting.DeleteActionImpl); ok {
+				return d.DeleteOptions
+			}
+		}
+
+		return metav1.DeleteOptions{}
+	}
+
+	t.Run("default grace period", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client, set := newClient()
+		rq.NoError(client.DeletePod(context.Background(), "default", "app-1", domain.DeleteOptions{}))
+		rq.Nil(deleteOptions(set).GracePeriodSeconds)
+
+		_, err := set.CoreV1().Pods("default").Get(context.Background(), "app-1", metav1.GetOptions{})
+		rq.Error(err)
+	})
+
+	t.Run("grace period", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client, set := newClient()
+		grace := int64(5)
+		rq.NoError(client.DeletePod(context.Background(), "default", "app-1", domain.DeleteOptions{GracePeriod: &grace}))
+		rq.Equal(int64(5), *deleteOptions(set).GracePeriodSeconds)
+	})
+
+	t.Run("zero grace period without force", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client, set := newClient()
+		grace := int64(0)
+		rq.NoError(client.DeletePod(context.Background(), "default", "app-1", domain.DeleteOptions{GracePeriod: &grace}))
+		rq.Equal(int64(1), *deleteOptions(set).GracePeriodSeconds)
+		rq.Equal(int64(0), grace)
+	})
+
+	t.Run("force", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client, set := newClient()
+		grace := int64(5)
+		rq.NoError(client.DeletePod(context.Background(), "default", "app-1",
+			domain.DeleteOptions{GracePeriod: &grace, Force: true}))
+		rq.Equal(int64(0), *deleteOptions(set).GracePeriodSeconds)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := newClient()
+		require.Error(t, client.DeletePod(context.Background(), "default", "app-2", domain.DeleteOptions{}))
+	})
+}
+
+func Test_getPodStatus(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	p := corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}}
+	rq.Equal("Running", getPodStatus(&p))
+
+	now := metav1.Now()
+	p.DeletionTimestamp = &now
+	rq.Equal("Terminating", getPodStatus(&p))
+}
@@ -0,0 +1,117 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// metricsAPIPath is the path of metrics.k8s.io API served by metrics-server.
+// It's requested with the raw REST client, so kubic doesn't depend on the metrics clientset.
+const metricsAPIPath = "/apis/metrics.k8s.io/v1beta1"
+
+type (
+	podMetricsList struct {
+		Items []podMetrics `json:"items"`
+	}
+	podMetrics struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Containers        []containerMetrics `json:"containers"`
+	}
+	containerMetrics struct {
+		Name  string              `json:"name"`
+		Usage corev1.ResourceList `json:"usage"`
+	}
+	nodeMetricsList struct {
+		Items []nodeMetrics `json:"items"`
+	}
+	nodeMetrics struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Usage             corev1.ResourceList `json:"usage"`
+	}
+)
+
+// GetPodMetrics returns the current usage of pods of the namespace. Empty namespace means all namespaces.
+// `domain.ErrMetricsUnavailable` is returned if the cluster doesn't serve the metrics API.
+func (c *Client) GetPodMetrics(ctx context.Context, namespace string) ([]domain.PodMetrics, error) {
+	path := metricsAPIPath + "/pods"
+	if namespace != metav1.NamespaceAll {
+		path = metricsAPIPath + "/namespaces/" + namespace + "/pods"
+	}
+
+	var list podMetricsList
+	if err := c.getMetrics(ctx, path, &list); err != nil {
+		return nil, err
+	}
+
+	metrics := make([]domain.PodMetrics, len(list.Items))
+	for i := range list.Items {
+		metrics[i] = toDomainPodMetrics(&list.Items[i])
+	}
+
+	return metrics, nil
+}
+
+// GetNodeMetrics returns the current usage of nodes.
+// `domain.ErrMetricsUnavailable` is returned if the cluster doesn't serve the metrics API.
+func (c *Client) GetNodeMetrics(ctx context.Context) ([]domain.NodeMetrics, error) {
+	var list nodeMetricsList
+	if err := c.getMetrics(ctx, metricsAPIPath+"/nodes", &list); err != nil {
+		return nil, err
+	}
+
+	metrics := make([]domain.NodeMetrics, len(list.Items))
+	for i := range list.Items {
+		metrics[i] = domain.NodeMetrics{
+			Name:  list.Items[i].Name,
+			Usage: toDomainResources(list.Items[i].Usage),
+		}
+	}
+
+	return metrics, nil
+}
+
+func (c *Client) getMetrics(ctx context.Context, path string, v interface{}) error {
+	data, err := c.clientSet().Discovery().RESTClient().Get().AbsPath(path).DoRaw(ctx)
+	if err != nil {
+		// the API group isn't registered or metrics-server behind it doesn't respond
+		if apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err) {
+			return domain.ErrMetricsUnavailable
+		}
+
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func toDomainPodMetrics(pm *podMetrics) domain.PodMetrics {
+	metrics := domain.PodMetrics{
+		Name:       pm.Name,
+		Namespace:  pm.Namespace,
+		Containers: make([]domain.ContainerMetrics, len(pm.Containers)),
+	}
+
+	for i := range pm.Containers {
+		usage := toDomainResources(pm.Containers[i].Usage)
+		metrics.Containers[i] = domain.ContainerMetrics{
+			Name:  pm.Containers[i].Name,
+			Usage: usage,
+		}
+		metrics.Usage.CPU += usage.CPU
+		metrics.Usage.Memory += usage.Memory
+	}
+
+	return metrics
+}
+
+func toDomainResources(rl corev1.ResourceList) domain.Resources {
+	return domain.Resources{
+		CPU:    rl.Cpu().MilliValue(),
+		Memory: rl.Memory().Value(),
+	}
+}
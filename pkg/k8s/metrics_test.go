@@ -0,0 +1,131 @@
+package k8s
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const testPodMetrics = `{
+  "kind": "PodMetricsList",
+  "apiVersion": "metrics.k8s.io/v1beta1",
+  "items": [
+    {
+      "metadata": {"name": "api-1", "namespace": "default"},
+      "containers": [
+        {"name": "api", "usage": {"cpu": "250m", "memory": "64Mi"}},
+        {"name": "proxy", "usage": {"cpu": "1500000n", "memory": "16Mi"}}
+      ]
+    }
+  ]
+}`
+
+const testNodeMetrics = `{
+  "kind": "NodeMetricsList",
+  "apiVersion": "metrics.k8s.io/v1beta1",
+  "items": [
+    {"metadata": {"name": "node-1"}, "usage": {"cpu": "2", "memory": "1Gi"}}
+  ]
+}`
+
+// newTestMetricsClient returns the client of the local server which stands in for the metrics API.
+// Paths which aren't in the responses are not found like on the cluster without metrics-server.
+func newTestMetricsClient(t *testing.T, responses map[string]string) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	set, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	require.NoError(t, err)
+
+	return &Client{set: set}
+}
+
+func Test_GetPodMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("namespace", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := newTestMetricsClient(t, map[string]string{
+			metricsAPIPath + "/namespaces/default/pods": testPodMetrics,
+		})
+
+		metrics, err := client.GetPodMetrics(context.Background(), "default")
+		rq.NoError(err)
+		rq.Len(metrics, 1)
+		rq.Equal("api-1", metrics[0].Name)
+		rq.Equal("default", metrics[0].Namespace)
+		rq.Equal([]domain.ContainerMetrics{
+			{Name: "api", Usage: domain.Resources{CPU: 250, Memory: 64 << 20}},
+			{Name: "proxy", Usage: domain.Resources{CPU: 2, Memory: 16 << 20}},
+		}, metrics[0].Containers)
+		rq.Equal(domain.Resources{CPU: 252, Memory: 80 << 20}, metrics[0].Usage)
+	})
+
+	t.Run("all namespaces", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := newTestMetricsClient(t, map[string]string{
+			metricsAPIPath + "/pods": testPodMetrics,
+		})
+
+		metrics, err := client.GetPodMetrics(context.Background(), "")
+		rq.NoError(err)
+		rq.Len(metrics, 1)
+	})
+
+	t.Run("metrics unavailable", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := newTestMetricsClient(t, nil)
+
+		_, err := client.GetPodMetrics(context.Background(), "default")
+		rq.ErrorIs(err, domain.ErrMetricsUnavailable)
+	})
+}
+
+func Test_GetNodeMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := newTestMetricsClient(t, map[string]string{
+			metricsAPIPath + "/nodes": testNodeMetrics,
+		})
+
+		metrics, err := client.GetNodeMetrics(context.Background())
+		rq.NoError(err)
+		rq.Equal([]domain.NodeMetrics{
+			{Name: "node-1", Usage: domain.Resources{CPU: 2000, Memory: 1 << 30}},
+		}, metrics)
+	})
+
+	t.Run("metrics unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newTestMetricsClient(t, nil).GetNodeMetrics(context.Background())
+		require.ErrorIs(t, err, domain.ErrMetricsUnavailable)
+	})
+}
@@ -0,0 +1,344 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	nodeRoleLabel       = "kubernetes.io/role"
+	daemonSetKind       = "DaemonSet"
+	// mirrorPodAnnotation marks static pods which are managed by kubelet and can't be evicted.
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+	nodeStatusReady     = "Ready"
+	nodeStatusNotReady  = "NotReady"
+	nodeStatusUnknown   = "Unknown"
+	schedulingDisabled  = "SchedulingDisabled"
+	podNodeNameField    = "spec.nodeName"
+)
+
+// Eviction is refused with 429 status while it would violate the pod disruption budget.
+// Like kubectl drain, it's retried: the delay is doubled up to the max one until the attempts are over.
+const (
+	evictionRetryDelay    = 5 * time.Second
+	evictionRetryMaxDelay = 30 * time.Second
+	evictionAttempts      = 10
+)
+
+func (c *Client) GetNodes(ctx context.Context) ([]domain.Node, error) {
+	apiResp, err := c.clientSet().CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := c.clientSet().CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	podCount := make(map[string]int, len(apiResp.Items))
+	for i := range pods.Items {
+		if isPodTerminated(&pods.Items[i]) {
+			continue
+		}
+		podCount[pods.Items[i].Spec.NodeName]++
+	}
+
+	nodes := make([]domain.Node, len(apiResp.Items))
+	for i := range apiResp.Items {
+		nodes[i] = toDomainNode(&apiResp.Items[i])
+		nodes[i].PodCount = podCount[nodes[i].Name]
+	}
+
+	return nodes, nil
+}
+
+// GetNodePods returns not terminated pods scheduled on the node.
+func (c *Client) GetNodePods(ctx context.Context, name string) ([]domain.Pod, error) {
+	pods, err := c.getNodePods(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]domain.Pod, len(pods))
+	for i := range pods {
+		resp[i] = toDomainPod(&pods[i])
+	}
+
+	return resp, nil
+}
+
+// CordonNode marks the node as unschedulable or schedulable again.
+func (c *Client) CordonNode(ctx context.Context, name string, unschedulable bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable))
+
+	_, err := c.clientSet().CoreV1().Nodes().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// DrainNode cordons the node and evicts its pods with the Eviction API, so pod disruption budgets are respected.
+// Pods of daemon sets and static pods are skipped as kubectl does. Pods which are lost on eviction
+// (not managed by a controller or with emptyDir volumes) are left on the node, like kubectl refuses them
+// without `--force` and `--delete-emptydir-data`.
+// Progress is sent to the returned channel, which is closed when all the pods are processed or ctx is done.
+// The last event is the error if any of the pods is not evicted.
+func (c *Client) DrainNode(ctx context.Context, name string) (<-chan domain.DrainEvent, error) {
+	if err := c.CordonNode(ctx, name, true); err != nil {
+		return nil, err
+	}
+
+	pods, err := c.getNodePods(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan domain.DrainEvent)
+
+	go func() {
+		defer close(events)
+
+		send := func(e domain.DrainEvent) bool {
+			select {
+			case events <- e:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if !send(domain.DrainEvent{Message: fmt.Sprintf("node %s cordoned, %d pods found", name, len(pods))}) {
+			return
+		}
+
+		var failed int
+		for i := range pods {
+			pod := pods[i].Namespace + "/" + pods[i].Name
+
+			if reason := skipEvictionReason(&pods[i]); reason != "" {
+				if !send(domain.DrainEvent{Pod: pod, Message: "skipped: " + reason}) {
+					return
+				}
+
+				continue
+			}
+
+			if reason := refuseEvictionReason(&pods[i]); reason != "" {
+				failed++
+				if !send(domain.DrainEvent{Pod: pod, Err: fmt.Errorf("not evicted: %s", reason)}) {
+					return
+				}
+
+				continue
+			}
+
+			e := domain.DrainEvent{Pod: pod, Message: "evicted"}
+			if err := c.evictPod(ctx, &pods[i], c.evictionRetryDelay, send); err != nil {
+				failed++
+				e = domain.DrainEvent{Pod: pod, Err: err}
+			}
+			if !send(e) {
+				return
+			}
+		}
+
+		if failed > 0 {
+			send(domain.DrainEvent{Err: fmt.Errorf("node %s is not drained: %d pods are not evicted", name, failed)})
+
+			return
+		}
+
+		send(domain.DrainEvent{Message: fmt.Sprintf("node %s drained", name)})
+	}()
+
+	return events, nil
+}
+
+// evictPod evicts the pod, the eviction refused by the disruption budget is retried with the growing delay.
+// Retries are reported with `send`. The pod which is already deleted is considered evicted.
+func (c *Client) evictPod(ctx context.Context, p *corev1.Pod, delay time.Duration, send func(domain.DrainEvent) bool) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = c.clientSet().CoreV1().Pods(p.Namespace).EvictV1(ctx, &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{Name: p.Name, Namespace: p.Namespace},
+		})
+		switch {
+		case err == nil, apierrors.IsNotFound(err):
+			return nil
+		case !apierrors.IsTooManyRequests(err) || attempt == evictionAttempts:
+			return err
+		}
+
+		msg := fmt.Sprintf("eviction is refused (%v), retrying in %s", err, delay)
+		if !send(domain.DrainEvent{Pod: p.Namespace + "/" + p.Name, Message: msg}) {
+			return ctx.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > evictionRetryMaxDelay {
+			delay = evictionRetryMaxDelay
+		}
+	}
+}
+
+func (c *Client) getNodePods(ctx context.Context, name string) ([]corev1.Pod, error) {
+	apiResp, err := c.clientSet().CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(podNodeNameField, name).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// field selector is the server side optimization, the result is checked to not depend on it
+	pods := make([]corev1.Pod, 0, len(apiResp.Items))
+	for i := range apiResp.Items {
+		if apiResp.Items[i].Spec.NodeName == name && !isPodTerminated(&apiResp.Items[i]) {
+			pods = append(pods, apiResp.Items[i])
+		}
+	}
+
+	return pods, nil
+}
+
+func isPodTerminated(p *corev1.Pod) bool {
+	return p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed
+}
+
+// refuseEvictionReason returns why the pod must not be evicted by the drain: the pod or its data would be lost.
+func refuseEvictionReason(p *corev1.Pod) string {
+	if metav1.GetControllerOf(p) == nil {
+		return "pod is not managed by a controller, it won't be created again"
+	}
+
+	for i := range p.Spec.Volumes {
+		if p.Spec.Volumes[i].EmptyDir != nil {
+			return fmt.Sprintf("pod uses emptyDir volume %s, its data would be lost", p.Spec.Volumes[i].Name)
+		}
+	}
+
+	return ""
+}
+
+func skipEvictionReason(p *corev1.Pod) string {
+	if _, ok := p.Annotations[mirrorPodAnnotation]; ok {
+		return "static pod"
+	}
+
+	for _, owner := range p.OwnerReferences {
+		if owner.Kind == daemonSetKind {
+			return "daemon set pod"
+		}
+	}
+
+	return ""
+}
+
+func toDomainNode(n *corev1.Node) domain.Node {
+	var node domain.Node
+
+	node.Name = n.Name
+	node.Roles = getNodeRoles(n.Labels)
+	node.Status = getNodeStatus(n)
+	node.Version = n.Status.NodeInfo.KubeletVersion
+	node.Unschedulable = n.Spec.Unschedulable
+	node.Labels = n.Labels
+	node.Created = n.CreationTimestamp.Time
+
+	age := time.Now().Unix() - n.GetCreationTimestamp().Unix()
+	node.Age = ageToString(age)
+
+	for _, addr := range n.Status.Addresses {
+		if addr.Type == corev1.NodeInternalIP {
+			node.InternalIP = addr.Address
+		}
+	}
+
+	node.Conditions = make([]domain.NodeCondition, len(n.Status.Conditions))
+	for i := range n.Status.Conditions {
+		node.Conditions[i] = domain.NodeCondition{
+			Type:    string(n.Status.Conditions[i].Type),
+			Status:  string(n.Status.Conditions[i].Status),
+			Reason:  n.Status.Conditions[i].Reason,
+			Message: n.Status.Conditions[i].Message,
+		}
+	}
+
+	node.Taints = make([]string, len(n.Spec.Taints))
+	for i := range n.Spec.Taints {
+		taint := n.Spec.Taints[i].Key
+		if n.Spec.Taints[i].Value != "" {
+			taint += "=" + n.Spec.Taints[i].Value
+		}
+		node.Taints[i] = taint + ":" + string(n.Spec.Taints[i].Effect)
+	}
+
+	node.Capacity = toResourcesMap(n.Status.Capacity)
+	node.Allocatable = toResourcesMap(n.Status.Allocatable)
+	node.AllocatableResources = toDomainResources(n.Status.Allocatable)
+
+	return node
+}
+
+func getNodeRoles(labels map[string]string) []string {
+	var roles []string
+	for k, v := range labels {
+		switch {
+		case strings.HasPrefix(k, nodeRoleLabelPrefix):
+			if role := strings.TrimPrefix(k, nodeRoleLabelPrefix); role != "" {
+				roles = append(roles, role)
+			}
+		case k == nodeRoleLabel && v != "":
+			roles = append(roles, v)
+		}
+	}
+	sort.Strings(roles)
+
+	return roles
+}
+
+func getNodeStatus(n *corev1.Node) string {
+	status := nodeStatusUnknown
+	for _, cond := range n.Status.Conditions {
+		if cond.Type != corev1.NodeReady {
+			continue
+		}
+		switch cond.Status {
+		case corev1.ConditionTrue:
+			status = nodeStatusReady
+		case corev1.ConditionFalse:
+			status = nodeStatusNotReady
+		}
+	}
+
+	if n.Spec.Unschedulable {
+		status += "," + schedulingDisabled
+	}
+
+	return status
+}
+
+func toResourcesMap(rl corev1.ResourceList) map[string]string {
+	resources := make(map[string]string, len(rl))
+	for name, q := range rl {
+		resources[string(name)] = q.String()
+	}
+
+	return resources
+}
@@ -0,0 +1,325 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newTestNodeObjects() []runtime.Object {
+	controller := true
+
+	return []runtime.Object{
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-1",
+				Labels: map[string]string{"node-role.kubernetes.io/control-plane": "", "kubernetes.io/role": "master"},
+			},
+			Spec: corev1.NodeSpec{
+				Taints: []corev1.Taint{
+					{Key: "node-role.kubernetes.io/control-plane", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "db", Effect: corev1.TaintEffectNoExecute},
+				},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				},
+				NodeInfo: corev1.NodeSystemInfo{KubeletVersion: "v1.24.3"},
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("4"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("3900m"),
+				},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "app-1",
+				Namespace:       "default",
+				OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "app", Controller: &controller}},
+			},
+			Spec: corev1.PodSpec{NodeName: "node-1"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "agent-1",
+				Namespace:       "kube-system",
+				OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "agent"}},
+			},
+			Spec: corev1.PodSpec{NodeName: "node-1"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-1", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node-1"},
+			Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-2", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node-2"},
+		},
+	}
+}
+
+func Test_GetNodes(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := Client{set: fake.NewSimpleClientset(newTestNodeObjects()...)}
+
+	nodes, err := client.GetNodes(context.Background())
+	rq.NoError(err)
+	rq.Len(nodes, 1)
+
+	node := nodes[0]
+	rq.Equal("node-1", node.Name)
+	rq.Equal([]string{"control-plane", "master"}, node.Roles)
+	rq.Equal("Ready", node.Status)
+	rq.Equal("v1.24.3", node.Version)
+	rq.Equal(2, node.PodCount)
+	rq.Equal([]string{"node-role.kubernetes.io/control-plane:NoSchedule", "dedicated=db:NoExecute"}, node.Taints)
+	rq.Equal("4", node.Capacity["cpu"])
+	rq.Equal("3900m", node.Allocatable["cpu"])
+}
+
+func Test_GetNodePods(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := Client{set: fake.NewSimpleClientset(newTestNodeObjects()...)}
+
+	pods, err := client.GetNodePods(context.Background(), "node-1")
+	rq.NoError(err)
+	rq.Len(pods, 2)
+	for i := range pods {
+		rq.NotEqual("job-1", pods[i].Name)
+		rq.NotEqual("app-2", pods[i].Name)
+	}
+}
+
+func Test_CordonNode(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := Client{set: fake.NewSimpleClientset(newTestNodeObjects()...)}
+
+	rq.NoError(client.CordonNode(context.Background(), "node-1", true))
+
+	nodes, err := client.GetNodes(context.Background())
+	rq.NoError(err)
+	rq.True(nodes[0].Unschedulable)
+	rq.Equal("Ready,SchedulingDisabled", nodes[0].Status)
+
+	rq.NoError(client.CordonNode(context.Background(), "node-1", false))
+
+	nodes, err = client.GetNodes(context.Background())
+	rq.NoError(err)
+	rq.False(nodes[0].Unschedulable)
+}
+
+func Test_DrainNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		set := fake.NewSimpleClientset(newTestNodeObjects()...)
+		var evicted []string
+		set.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "eviction" {
+				return false, nil, nil
+			}
+			eviction, ok := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+			rq.True(ok)
+			evicted = append(evicted, eviction.Namespace+"/"+eviction.Name)
+
+			return true, nil, nil
+		})
+		client := Client{set: set}
+
+		events, err := client.DrainNode(context.Background(), "node-1")
+		rq.NoError(err)
+
+		var got []domain.DrainEvent
+		for e := range events {
+			got = append(got, e)
+		}
+
+		rq.Equal([]string{"default/app-1"}, evicted)
+		rq.Len(got, 4)
+		rq.Contains(got, domain.DrainEvent{Pod: "default/app-1", Message: "evicted"})
+		rq.Contains(got, domain.DrainEvent{Pod: "kube-system/agent-1", Message: "skipped: daemon set pod"})
+
+		node, err := set.CoreV1().Nodes().Get(context.Background(), "node-1", metav1.GetOptions{})
+		rq.NoError(err)
+		rq.True(node.Spec.Unschedulable)
+	})
+
+	t.Run("eviction error", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		set := fake.NewSimpleClientset(newTestNodeObjects()...)
+		set.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return action.GetSubresource() == "eviction", nil, errors.New("disruption budget")
+		})
+		client := Client{set: set}
+
+		events, err := client.DrainNode(context.Background(), "node-1")
+		rq.NoError(err)
+
+		var failed []domain.DrainEvent
+		for e := range events {
+			if e.Err != nil {
+				failed = append(failed, e)
+			}
+		}
+
+		// the pod error and the drain error at the end
+		rq.Len(failed, 2)
+		rq.Equal("default/app-1", failed[0].Pod)
+		rq.Empty(failed[1].Pod)
+		rq.Contains(failed[1].Err.Error(), "not drained")
+	})
+
+	t.Run("disruption budget retry", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		set := fake.NewSimpleClientset(newTestNodeObjects()...)
+		var attempts int
+		set.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "eviction" {
+				return false, nil, nil
+			}
+			if attempts++; attempts < 3 {
+				return true, nil, apierrors.NewTooManyRequests("disruption budget", 0)
+			}
+
+			return true, nil, nil
+		})
+		client := Client{set: set, evictionRetryDelay: time.Millisecond}
+
+		events, err := client.DrainNode(context.Background(), "node-1")
+		rq.NoError(err)
+
+		var got []domain.DrainEvent
+		for e := range events {
+			rq.NoError(e.Err)
+			got = append(got, e)
+		}
+
+		rq.Equal(3, attempts)
+		rq.Contains(got, domain.DrainEvent{Pod: "default/app-1", Message: "evicted"})
+		rq.Equal("node node-1 drained", got[len(got)-1].Message)
+	})
+
+	t.Run("disruption budget retries are over", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		set := fake.NewSimpleClientset(newTestNodeObjects()...)
+		var attempts int
+		set.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "eviction" {
+				return false, nil, nil
+			}
+			attempts++
+
+			return true, nil, apierrors.NewTooManyRequests("disruption budget", 0)
+		})
+		client := Client{set: set, evictionRetryDelay: time.Millisecond}
+
+		events, err := client.DrainNode(context.Background(), "node-1")
+		rq.NoError(err)
+
+		var last domain.DrainEvent
+		for e := range events {
+			last = e
+		}
+
+		rq.Equal(evictionAttempts, attempts)
+		rq.Error(last.Err)
+		rq.Empty(last.Pod)
+	})
+
+	t.Run("pods lost on eviction", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		controller := true
+		objects := append(newTestNodeObjects(),
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: "default"},
+				Spec:       corev1.PodSpec{NodeName: "node-1"},
+			},
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "cache-1",
+					Namespace:       "default",
+					OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "cache", Controller: &controller}},
+				},
+				Spec: corev1.PodSpec{
+					NodeName: "node-1",
+					Volumes: []corev1.Volume{
+						{Name: "data", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+					},
+				},
+			},
+		)
+		set := fake.NewSimpleClientset(objects...)
+		var evicted []string
+		set.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "eviction" {
+				return false, nil, nil
+			}
+			eviction, ok := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+			rq.True(ok)
+			evicted = append(evicted, eviction.Namespace+"/"+eviction.Name)
+
+			return true, nil, nil
+		})
+		client := Client{set: set}
+
+		events, err := client.DrainNode(context.Background(), "node-1")
+		rq.NoError(err)
+
+		var failed []string
+		var last domain.DrainEvent
+		for e := range events {
+			if e.Err != nil && e.Pod != "" {
+				failed = append(failed, e.Pod)
+			}
+			last = e
+		}
+
+		rq.Equal([]string{"default/app-1"}, evicted)
+		rq.ElementsMatch([]string{"default/manual", "default/cache-1"}, failed)
+		rq.Error(last.Err)
+		rq.Contains(last.Err.Error(), "2 pods are not evicted")
+	})
+
+	t.Run("node not found", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := Client{set: fake.NewSimpleClientset()}
+
+		_, err := client.DrainNode(context.Background(), "node-1")
+		rq.Error(err)
+	})
+}
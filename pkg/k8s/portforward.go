@@ -0,0 +1,452 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+const (
+	// portForwardAddress is the local address the forwarded ports listen on, both IPv4 and IPv6 loopback.
+	portForwardAddress = "localhost"
+	maxPort            = 65535
+)
+
+// dialerFactory creates a dialer of the streaming connection for the request url.
+// It's a SPDY dialer for the real cluster, the connection is replaced in tests.
+type dialerFactory func(config *rest.Config, u *url.URL) (httpstream.Dialer, error)
+
+func newSPDYDialer(config *rest.Config, u *url.URL) (httpstream.Dialer, error) {
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, u), nil
+}
+
+// portForwards keeps the port forwards of the client. They run in the background
+// until they are stopped, so they don't depend on the UI state.
+type portForwards struct {
+	mu     sync.Mutex
+	lastID int
+	items  []*portForward
+}
+
+type portForward struct {
+	// set, config and restClient are the connection of the context the forward is started in:
+	// it keeps forwarding to the same cluster when the client is switched to another context.
+	set        kubernetes.Interface
+	config     *rest.Config
+	restClient rest.Interface
+	newDialer  dialerFactory
+	mu         sync.Mutex
+	state      domain.PortForward
+	// stop is closed to stop forwarding, done is closed when forwarding is over.
+	stop chan struct{}
+	done chan struct{}
+	// bytesIn and bytesOut are updated by the connection streams, they are accessed atomically.
+	bytesIn  int64
+	bytesOut int64
+}
+
+// StartPortForward starts forwarding the local port to the target in the background.
+// Zero local port picks a random free port. Listening errors are reported by the forward status.
+func (c *Client) StartPortForward(ctx context.Context, target domain.PortForwardTarget, localPort int) (domain.PortForward, error) {
+	if target.Port < 1 || target.Port > maxPort {
+		return domain.PortForward{}, fmt.Errorf("invalid port %d", target.Port)
+	}
+	if localPort < 0 || localPort > maxPort {
+		return domain.PortForward{}, fmt.Errorf("invalid local port %d", localPort)
+	}
+
+	c.mu.RLock()
+	pf := &portForward{
+		set:        c.set,
+		config:     c.config,
+		restClient: c.restClient,
+		newDialer:  c.newDialer,
+		state: domain.PortForward{
+			Context:   c.contextName,
+			Target:    target,
+			LocalPort: localPort,
+		},
+	}
+	c.mu.RUnlock()
+
+	pod, remotePort, err := resolvePortForwardTarget(ctx, pf.set, target)
+	if err != nil {
+		return domain.PortForward{}, err
+	}
+
+	c.forwards.mu.Lock()
+	c.forwards.lastID++
+	pf.state.ID = c.forwards.lastID
+	c.forwards.items = append(c.forwards.items, pf)
+	c.forwards.mu.Unlock()
+
+	runPortForward(pf, pod, remotePort)
+
+	return pf.snapshot(), nil
+}
+
+// StopPortForward stops the port forward. Stopped forward is kept in the list and can be restarted.
+func (c *Client) StopPortForward(id int) error {
+	pf := c.getPortForward(id)
+	if pf == nil {
+		return fmt.Errorf("port forward %d not found", id)
+	}
+
+	pf.halt()
+
+	return nil
+}
+
+// RestartPortForward stops the port forward and starts it again on the same local port.
+// The target is resolved again: the pod of the service could have been replaced.
+func (c *Client) RestartPortForward(ctx context.Context, id int) error {
+	pf := c.getPortForward(id)
+	if pf == nil {
+		return fmt.Errorf("port forward %d not found", id)
+	}
+
+	pf.halt()
+
+	state := pf.snapshot()
+	pod, remotePort, err := resolvePortForwardTarget(ctx, pf.set, state.Target)
+	if err != nil {
+		return err
+	}
+
+	runPortForward(pf, pod, remotePort)
+
+	return nil
+}
+
+// RemovePortForward stops the port forward and removes it from the list.
+func (c *Client) RemovePortForward(id int) error {
+	pf := c.getPortForward(id)
+	if pf == nil {
+		return fmt.Errorf("port forward %d not found", id)
+	}
+
+	pf.halt()
+
+	c.forwards.mu.Lock()
+	defer c.forwards.mu.Unlock()
+
+	for i := range c.forwards.items {
+		if c.forwards.items[i] == pf {
+			c.forwards.items = append(c.forwards.items[:i], c.forwards.items[i+1:]...)
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// GetPortForwards returns the current state of all the port forwards in the order they were started.
+func (c *Client) GetPortForwards() []domain.PortForward {
+	c.forwards.mu.Lock()
+	items := make([]*portForward, len(c.forwards.items))
+	copy(items, c.forwards.items)
+	c.forwards.mu.Unlock()
+
+	forwards := make([]domain.PortForward, len(items))
+	for i := range items {
+		forwards[i] = items[i].snapshot()
+	}
+
+	return forwards
+}
+
+// StopPortForwards stops all the port forwards and waits until their listeners are closed.
+func (c *Client) StopPortForwards() {
+	c.forwards.mu.Lock()
+	items := make([]*portForward, len(c.forwards.items))
+	copy(items, c.forwards.items)
+	c.forwards.mu.Unlock()
+
+	for i := range items {
+		items[i].halt()
+	}
+}
+
+func (c *Client) getPortForward(id int) *portForward {
+	c.forwards.mu.Lock()
+	defer c.forwards.mu.Unlock()
+
+	for i := range c.forwards.items {
+		if c.forwards.items[i].state.ID == id {
+			return c.forwards.items[i]
+		}
+	}
+
+	return nil
+}
+
+// runPortForward starts forwarding to the pod port in the background.
+func runPortForward(pf *portForward, pod string, remotePort int) {
+	stop, done := make(chan struct{}), make(chan struct{})
+
+	pf.mu.Lock()
+	pf.state.Pod = pod
+	pf.state.RemotePort = remotePort
+	pf.state.Status = domain.PortForwardStarting
+	pf.state.Started = time.Now()
+	pf.state.Err = nil
+	pf.stop, pf.done = stop, done
+	localPort := pf.state.LocalPort
+	namespace := pf.state.Target.Namespace
+	pf.mu.Unlock()
+
+	atomic.StoreInt64(&pf.bytesIn, 0)
+	atomic.StoreInt64(&pf.bytesOut, 0)
+
+	go func() {
+		defer close(done)
+
+		err := forwardPorts(pf, namespace, pod, localPort, remotePort, stop)
+
+		pf.mu.Lock()
+		defer pf.mu.Unlock()
+
+		select {
+		case <-stop:
+			pf.state.Status = domain.PortForwardStopped
+		default:
+			// forwarder returns without error when the connection to the pod is lost
+			if err == nil {
+				err = errors.New("lost connection to pod")
+			}
+			pf.state.Status = domain.PortForwardFailed
+			pf.state.Err = err
+		}
+	}()
+}
+
+// forwardPorts blocks until the forwarding is stopped or failed.
+func forwardPorts(pf *portForward, namespace, pod string, localPort, remotePort int, stop chan struct{}) error {
+	req := pf.restClient.Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("portforward")
+
+	dialer, err := pf.newDialer(pf.config, req.URL())
+	if err != nil {
+		return err
+	}
+
+	ready := make(chan struct{})
+	forwarder, err := portforward.NewOnAddresses(
+		&countingDialer{Dialer: dialer, pf: pf},
+		[]string{portForwardAddress},
+		[]string{fmt.Sprintf("%d:%d", localPort, remotePort)},
+		stop, ready, nil, nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- forwarder.ForwardPorts()
+	}()
+
+	select {
+	case <-ready:
+	case err = <-result:
+		return err
+	}
+
+	ports, err := forwarder.GetPorts()
+	if err == nil && len(ports) > 0 {
+		pf.mu.Lock()
+		// the random local port is picked by the listener
+		pf.state.LocalPort = int(ports[0].Local)
+		pf.state.Status = domain.PortForwardActive
+		pf.mu.Unlock()
+	}
+
+	return <-result
+}
+
+// resolvePortForwardTarget returns the pod and its port which receive the traffic of the target.
+// Service is resolved the same way `kubectl port-forward` does: to a running pod of the service
+// and the service target port, named target port is looked up in the pod containers.
+func resolvePortForwardTarget(ctx context.Context, set kubernetes.Interface, target domain.PortForwardTarget) (string, int, error) {
+	if target.Kind == domain.PortForwardTargetPod {
+		return target.Name, target.Port, nil
+	}
+
+	svc, err := set.CoreV1().Services(target.Namespace).Get(ctx, target.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, err
+	}
+
+	var svcPort *corev1.ServicePort
+	for i := range svc.Spec.Ports {
+		if int(svc.Spec.Ports[i].Port) == target.Port {
+			svcPort = &svc.Spec.Ports[i]
+
+			break
+		}
+	}
+	if svcPort == nil {
+		return "", 0, fmt.Errorf("service %s has no port %d", svc.Name, target.Port)
+	}
+
+	if len(svc.Spec.Selector) == 0 {
+		return "", 0, fmt.Errorf("service %s has no selector", svc.Name)
+	}
+
+	pods, err := set.CoreV1().Pods(target.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	pod := getRunningPod(pods.Items)
+	if pod == nil {
+		return "", 0, fmt.Errorf("service %s has no running pods", svc.Name)
+	}
+
+	port, err := getContainerPort(pod, svcPort)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return pod.Name, port, nil
+}
+
+// getRunningPod returns the first ready pod, or the first running one if none of them is ready.
+func getRunningPod(pods []corev1.Pod) *corev1.Pod {
+	var running *corev1.Pod
+	for i := range pods {
+		if pods[i].Status.Phase != corev1.PodRunning || pods[i].DeletionTimestamp != nil {
+			continue
+		}
+		if isPodReady(pods[i].Status.Conditions) {
+			return &pods[i]
+		}
+		if running == nil {
+			running = &pods[i]
+		}
+	}
+
+	return running
+}
+
+// getContainerPort returns the pod port the service port is targeted to.
+func getContainerPort(pod *corev1.Pod, svcPort *corev1.ServicePort) (int, error) {
+	if svcPort.TargetPort.Type == intstr.Int {
+		if svcPort.TargetPort.IntVal == 0 {
+			return int(svcPort.Port), nil
+		}
+
+		return int(svcPort.TargetPort.IntVal), nil
+	}
+
+	for i := range pod.Spec.Containers {
+		for _, port := range pod.Spec.Containers[i].Ports {
+			if port.Name == svcPort.TargetPort.StrVal {
+				return int(port.ContainerPort), nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("pod %s has no port named %s", pod.Name, svcPort.TargetPort.StrVal)
+}
+
+// halt stops the forwarding and waits until it's over. Nothing happens if it's not running.
+func (pf *portForward) halt() {
+	pf.mu.Lock()
+	stop, done := pf.stop, pf.done
+	pf.stop = nil
+	pf.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+
+	close(stop)
+	<-done
+}
+
+func (pf *portForward) snapshot() domain.PortForward {
+	pf.mu.Lock()
+	state := pf.state
+	pf.mu.Unlock()
+
+	state.BytesIn = atomic.LoadInt64(&pf.bytesIn)
+	state.BytesOut = atomic.LoadInt64(&pf.bytesOut)
+
+	return state
+}
+
+// countingDialer counts the bytes passed through the data streams of the forwarded connections.
+type countingDialer struct {
+	httpstream.Dialer
+	pf *portForward
+}
+
+func (d *countingDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, protocol, err := d.Dialer.Dial(protocols...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &countingConnection{Connection: conn, pf: d.pf}, protocol, nil
+}
+
+type countingConnection struct {
+	httpstream.Connection
+	pf *portForward
+}
+
+func (c *countingConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	stream, err := c.Connection.CreateStream(headers)
+	if err != nil || headers.Get(corev1.StreamType) != corev1.StreamTypeData {
+		return stream, err
+	}
+
+	return &countingStream{Stream: stream, pf: c.pf}, nil
+}
+
+type countingStream struct {
+	httpstream.Stream
+	pf *portForward
+}
+
+func (s *countingStream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	atomic.AddInt64(&s.pf.bytesIn, int64(n))
+
+	return n, err
+}
+
+func (s *countingStream) Write(p []byte) (int, error) {
+	n, err := s.Stream.Write(p)
+	atomic.AddInt64(&s.pf.bytesOut, int64(n))
+
+	return n, err
+}
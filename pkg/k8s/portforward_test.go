@@ -0,0 +1,313 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	forwardWaitTimeout = 5 * time.Second
+	forwardWaitTick    = 10 * time.Millisecond
+)
+
+// fakeStream stands in for the pod side of the stream: everything written to it is read back.
+type fakeStream struct {
+	headers http.Header
+	reader  *io.PipeReader
+	writer  *io.PipeWriter
+}
+
+func newFakeStream(headers http.Header) *fakeStream {
+	r, w := io.Pipe()
+
+	return &fakeStream{headers: headers, reader: r, writer: w}
+}
+
+func (s *fakeStream) Read(p []byte) (int, error)  { return s.reader.Read(p) }
+func (s *fakeStream) Write(p []byte) (int, error) { return s.writer.Write(p) }
+func (s *fakeStream) Close() error                { return s.writer.Close() }
+func (s *fakeStream) Headers() http.Header        { return s.headers }
+func (s *fakeStream) Identifier() uint32          { return 0 }
+
+func (s *fakeStream) Reset() error {
+	s.writer.Close()
+
+	return s.reader.Close()
+}
+
+type fakeConnection struct {
+	closeOnce sync.Once
+	closed    chan bool
+}
+
+func (c *fakeConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	return newFakeStream(headers.Clone()), nil
+}
+
+func (c *fakeConnection) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+
+	return nil
+}
+
+func (c *fakeConnection) CloseChan() <-chan bool               { return c.closed }
+func (c *fakeConnection) SetIdleTimeout(_ time.Duration)       {}
+func (c *fakeConnection) RemoveStreams(_ ...httpstream.Stream) {}
+
+// fakeDialer records the urls of the forward requests and opens fake connections.
+type fakeDialer struct {
+	mu   sync.Mutex
+	urls []string
+	err  error
+}
+
+func (d *fakeDialer) factory(_ *rest.Config, u *url.URL) (httpstream.Dialer, error) {
+	d.mu.Lock()
+	d.urls = append(d.urls, u.Path)
+	d.mu.Unlock()
+
+	return d, nil
+}
+
+func (d *fakeDialer) getURLs() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.urls
+}
+
+func (d *fakeDialer) Dial(_ ...string) (httpstream.Connection, string, error) {
+	if d.err != nil {
+		return nil, "", d.err
+	}
+
+	return &fakeConnection{closed: make(chan bool)}, "", nil
+}
+
+func newTestForwardClient(t *testing.T, dialer *fakeDialer, objects ...runtime.Object) *Client {
+	t.Helper()
+
+	config := &rest.Config{Host: "http://localhost"}
+	set, err := kubernetes.NewForConfig(config)
+	require.NoError(t, err)
+
+	return &Client{
+		set:        fake.NewSimpleClientset(objects...),
+		config:     config,
+		restClient: set.CoreV1().RESTClient(),
+		newDialer:  dialer.factory,
+	}
+}
+
+func waitForwardStatus(t *testing.T, client *Client, status string) domain.PortForward {
+	t.Helper()
+
+	var forward domain.PortForward
+	require.Eventually(t, func() bool {
+		forward = client.GetPortForwards()[0]
+
+		return forward.Status == status
+	}, forwardWaitTimeout, forwardWaitTick)
+
+	return forward
+}
+
+func Test_StartPortForward(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pod", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		dialer := &fakeDialer{}
+		client := newTestForwardClient(t, dialer)
+		defer client.StopPortForwards()
+
+		target := domain.PortForwardTarget{
+			Kind:      domain.PortForwardTargetPod,
+			Namespace: "default",
+			Name:      "app-1",
+			Port:      80,
+		}
+		started, err := client.StartPortForward(context.Background(), target, 0)
+		rq.NoError(err)
+		rq.Equal(1, started.ID)
+		rq.Equal("app-1", started.Pod)
+		rq.Equal(80, started.RemotePort)
+
+		forward := waitForwardStatus(t, client, domain.PortForwardActive)
+		rq.NotZero(forward.LocalPort)
+		rq.Equal([]string{"/api/v1/namespaces/default/pods/app-1/portforward"}, dialer.getURLs())
+
+		conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", forward.LocalPort))
+		rq.NoError(err)
+		_, err = conn.Write([]byte("ping"))
+		rq.NoError(err)
+		reply := make([]byte, 4)
+		_, err = io.ReadFull(conn, reply)
+		rq.NoError(err)
+		rq.Equal("ping", string(reply))
+		rq.NoError(conn.Close())
+
+		rq.Eventually(func() bool {
+			forward = client.GetPortForwards()[0]
+
+			return forward.BytesIn == 4 && forward.BytesOut == 4
+		}, forwardWaitTimeout, forwardWaitTick)
+
+		rq.NoError(client.StopPortForward(forward.ID))
+		rq.Equal(domain.PortForwardStopped, client.GetPortForwards()[0].Status)
+		_, err = net.Dial("tcp", fmt.Sprintf("localhost:%d", forward.LocalPort))
+		rq.Error(err)
+
+		rq.NoError(client.RestartPortForward(context.Background(), forward.ID))
+		restarted := waitForwardStatus(t, client, domain.PortForwardActive)
+		rq.Equal(forward.LocalPort, restarted.LocalPort)
+		rq.Zero(restarted.BytesIn)
+
+		rq.NoError(client.RemovePortForward(forward.ID))
+		rq.Empty(client.GetPortForwards())
+		rq.Error(client.StopPortForward(forward.ID))
+	})
+
+	t.Run("service", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		labels := map[string]string{"app": "api"}
+		client := newTestForwardClient(t, &fakeDialer{},
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Selector: labels,
+					Ports: []corev1.ServicePort{
+						{Port: 80, TargetPort: intstr.FromString("http")},
+						{Port: 9090},
+					},
+				},
+			},
+			newForwardPod("api-pending", labels, corev1.PodPending, false),
+			newForwardPod("api-1", labels, corev1.PodRunning, true),
+		)
+		defer client.StopPortForwards()
+
+		target := domain.PortForwardTarget{
+			Kind:      domain.PortForwardTargetService,
+			Namespace: "default",
+			Name:      "api",
+			Port:      80,
+		}
+		started, err := client.StartPortForward(context.Background(), target, 0)
+		rq.NoError(err)
+		rq.Equal("api-1", started.Pod)
+		rq.Equal(8080, started.RemotePort)
+
+		pod, port, err := resolvePortForwardTarget(context.Background(), client.set, domain.PortForwardTarget{
+			Kind:      domain.PortForwardTargetService,
+			Namespace: "default",
+			Name:      "api",
+			Port:      9090,
+		})
+		rq.NoError(err)
+		rq.Equal("api-1", pod)
+		rq.Equal(9090, port)
+
+		_, err = client.StartPortForward(context.Background(), domain.PortForwardTarget{
+			Kind:      domain.PortForwardTargetService,
+			Namespace: "default",
+			Name:      "api",
+			Port:      443,
+		}, 0)
+		rq.Error(err)
+	})
+
+	t.Run("service without running pods", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		labels := map[string]string{"app": "api"}
+		client := newTestForwardClient(t, &fakeDialer{},
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Selector: labels,
+					Ports:    []corev1.ServicePort{{Port: 80}},
+				},
+			},
+			newForwardPod("api-pending", labels, corev1.PodPending, false),
+		)
+
+		_, err := client.StartPortForward(context.Background(), domain.PortForwardTarget{
+			Kind:      domain.PortForwardTargetService,
+			Namespace: "default",
+			Name:      "api",
+			Port:      80,
+		}, 0)
+		rq.Error(err)
+		rq.Empty(client.GetPortForwards())
+	})
+
+	t.Run("dial error", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := newTestForwardClient(t, &fakeDialer{err: errors.New("upgrade refused")})
+		defer client.StopPortForwards()
+
+		_, err := client.StartPortForward(context.Background(), domain.PortForwardTarget{
+			Kind:      domain.PortForwardTargetPod,
+			Namespace: "default",
+			Name:      "app-1",
+			Port:      80,
+		}, 0)
+		rq.NoError(err)
+
+		forward := waitForwardStatus(t, client, domain.PortForwardFailed)
+		rq.Error(forward.Err)
+		rq.Contains(forward.Err.Error(), "upgrade refused")
+	})
+}
+
+func newForwardPod(name string, labels map[string]string, phase corev1.PodPhase, ready bool) *corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "api",
+					Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase:      phase,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+		},
+	}
+}
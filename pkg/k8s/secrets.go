@@ -0,0 +1,122 @@
+package k8s
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"sort"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const pemCertificateType = "CERTIFICATE"
+
+func (c *Client) GetSecrets(ctx context.Context, namespace string) ([]domain.Secret, error) {
+	apiResp, err := c.clientSet().CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make([]domain.Secret, len(apiResp.Items))
+	for i := range apiResp.Items {
+		secrets[i] = toDomainSecret(&apiResp.Items[i])
+	}
+
+	return secrets, nil
+}
+
+func toDomainSecret(s *corev1.Secret) domain.Secret {
+	var secret domain.Secret
+
+	secret.Name = s.Name
+	secret.Namespace = s.Namespace
+	secret.Type = string(s.Type)
+	secret.Data = s.Data
+	secret.Labels = s.Labels
+	secret.Created = s.CreationTimestamp.Time
+
+	age := time.Now().Unix() - s.GetCreationTimestamp().Unix()
+	secret.Age = ageToString(age)
+
+	switch s.Type {
+	case corev1.SecretTypeTLS:
+		secret.Certificates = parseCertificates(s.Data[corev1.TLSCertKey])
+	case corev1.SecretTypeDockerConfigJson:
+		secret.Registries = parseDockerConfigJSON(s.Data[corev1.DockerConfigJsonKey])
+	case corev1.SecretTypeDockercfg:
+		secret.Registries = parseDockerCfg(s.Data[corev1.DockerConfigKey])
+	}
+
+	return secret
+}
+
+// parseCertificates parses all PEM encoded certificates of the chain. Broken blocks are skipped.
+func parseCertificates(data []byte) []domain.Certificate {
+	var certs []domain.Certificate
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+		if block.Type != pemCertificateType {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+
+		ips := make([]string, len(cert.IPAddresses))
+		for i := range cert.IPAddresses {
+			ips[i] = cert.IPAddresses[i].String()
+		}
+
+		certs = append(certs, domain.Certificate{
+			Subject:   cert.Subject.String(),
+			Issuer:    cert.Issuer.String(),
+			DNSNames:  cert.DNSNames,
+			IPs:       ips,
+			NotBefore: cert.NotBefore,
+			NotAfter:  cert.NotAfter,
+		})
+	}
+}
+
+// parseDockerConfigJSON returns registries of `.dockerconfigjson`. Credentials are never read.
+func parseDockerConfigJSON(data []byte) []string {
+	var cfg struct {
+		Auths map[string]json.RawMessage `json:"auths"`
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil
+	}
+
+	return sortedKeys(cfg.Auths)
+}
+
+// parseDockerCfg returns registries of legacy `.dockercfg`, which is the `auths` map itself.
+func parseDockerCfg(data []byte) []string {
+	var auths map[string]json.RawMessage
+	if err := json.Unmarshal(data, &auths); err != nil {
+		return nil
+	}
+
+	return sortedKeys(auths)
+}
+
+func sortedKeys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
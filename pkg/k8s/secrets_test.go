@@ -0,0 +1,130 @@
+package k8s
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_GetSecrets(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := Client{
+		set: fake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"password": []byte("secret")},
+		}),
+	}
+
+	secrets, err := client.GetSecrets(context.Background(), "default")
+	rq.NoError(err)
+	rq.Len(secrets, 1)
+	rq.Equal("db", secrets[0].Name)
+	rq.Equal("Opaque", secrets[0].Type)
+	rq.Equal([]byte("secret"), secrets[0].Data["password"])
+	rq.Empty(secrets[0].Certificates)
+	rq.Empty(secrets[0].Registries)
+}
+
+func Test_toDomainSecret(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tls", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		crt := generateCertificate(t, notAfter)
+
+		secret := toDomainSecret(&corev1.Secret{
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       crt,
+				corev1.TLSPrivateKeyKey: []byte("key"),
+			},
+		})
+
+		rq.Len(secret.Certificates, 1)
+		cert := secret.Certificates[0]
+		rq.Equal("CN=example.com", cert.Subject)
+		rq.Equal([]string{"example.com", "www.example.com"}, cert.DNSNames)
+		rq.Equal([]string{"10.0.0.1"}, cert.IPs)
+		rq.True(notAfter.Equal(cert.NotAfter))
+	})
+
+	t.Run("broken tls", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		secret := toDomainSecret(&corev1.Secret{
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{corev1.TLSCertKey: []byte("not a certificate")},
+		})
+
+		rq.Empty(secret.Certificates)
+	})
+
+	t.Run("dockerconfigjson", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		secret := toDomainSecret(&corev1.Secret{
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"eDp5"},"ghcr.io":{"auth":"eDp5"}}}`),
+			},
+		})
+
+		rq.Equal([]string{"ghcr.io", "quay.io"}, secret.Registries)
+	})
+
+	t.Run("dockercfg", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		secret := toDomainSecret(&corev1.Secret{
+			Type: corev1.SecretTypeDockercfg,
+			Data: map[string][]byte{
+				corev1.DockerConfigKey: []byte(`{"registry.example.com":{"auth":"eDp5"}}`),
+			},
+		})
+
+		rq.Equal([]string{"registry.example.com"}, secret.Registries)
+	})
+}
+
+func generateCertificate(t *testing.T, notAfter time.Time) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com", "www.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
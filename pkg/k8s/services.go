@@ -0,0 +1,91 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func (c *Client) GetServices(ctx context.Context, namespace string) ([]domain.Service, error) {
+	apiResp, err := c.clientSet().CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]domain.Service, len(apiResp.Items))
+	for i := range apiResp.Items {
+		services[i] = toDomainService(&apiResp.Items[i])
+	}
+
+	return services, nil
+}
+
+// GetPodsBySelector returns pods matching the label selector.
+// Empty selector matches nothing: service without selector has manually managed endpoints.
+func (c *Client) GetPodsBySelector(ctx context.Context, namespace string, selector map[string]string) ([]domain.Pod, error) {
+	if len(selector) == 0 {
+		return nil, nil
+	}
+
+	apiResp, err := c.clientSet().CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make([]domain.Pod, len(apiResp.Items))
+	for i := range apiResp.Items {
+		pods[i] = toDomainPod(&apiResp.Items[i])
+	}
+
+	return pods, nil
+}
+
+func toDomainService(s *corev1.Service) domain.Service {
+	var svc domain.Service
+
+	svc.Name = s.Name
+	svc.Namespace = s.Namespace
+	svc.Type = string(s.Spec.Type)
+	svc.ClusterIP = s.Spec.ClusterIP
+	svc.ExternalIPs = getExternalIPs(s)
+	svc.Selector = s.Spec.Selector
+	svc.Labels = s.Labels
+	svc.Created = s.CreationTimestamp.Time
+
+	age := time.Now().Unix() - s.GetCreationTimestamp().Unix()
+	svc.Age = ageToString(age)
+
+	svc.Ports = make([]domain.ServicePort, len(s.Spec.Ports))
+	for i := range s.Spec.Ports {
+		svc.Ports[i] = domain.ServicePort{
+			Name:       s.Spec.Ports[i].Name,
+			Protocol:   string(s.Spec.Ports[i].Protocol),
+			Port:       int(s.Spec.Ports[i].Port),
+			TargetPort: s.Spec.Ports[i].TargetPort.String(),
+			NodePort:   int(s.Spec.Ports[i].NodePort),
+		}
+	}
+
+	return svc
+}
+
+// getExternalIPs returns both external IPs from spec and load balancer ingress addresses.
+func getExternalIPs(s *corev1.Service) []string {
+	ips := make([]string, 0, len(s.Spec.ExternalIPs)+len(s.Status.LoadBalancer.Ingress))
+	ips = append(ips, s.Spec.ExternalIPs...)
+	for _, ingress := range s.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			ips = append(ips, ingress.IP)
+		} else if ingress.Hostname != "" {
+			ips = append(ips, ingress.Hostname)
+		}
+	}
+
+	return ips
+}
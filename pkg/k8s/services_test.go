@@ -0,0 +1,91 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_GetServices(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	client := Client{
+		set: fake.NewSimpleClientset(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+			Spec: corev1.ServiceSpec{
+				Type:        corev1.ServiceTypeLoadBalancer,
+				ClusterIP:   "10.0.0.10",
+				ExternalIPs: []string{"192.168.0.10"},
+				Selector:    map[string]string{"app": "api"},
+				Ports: []corev1.ServicePort{
+					{
+						Name:       "http",
+						Protocol:   corev1.ProtocolTCP,
+						Port:       80,
+						TargetPort: intstr.FromString("http"),
+						NodePort:   30080,
+					},
+				},
+			},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}},
+				},
+			},
+		}),
+	}
+
+	services, err := client.GetServices(context.Background(), "default")
+	rq.NoError(err)
+	rq.Len(services, 1)
+
+	svc := services[0]
+	rq.Equal("api", svc.Name)
+	rq.Equal("LoadBalancer", svc.Type)
+	rq.Equal("10.0.0.10", svc.ClusterIP)
+	rq.Equal([]string{"192.168.0.10", "lb.example.com"}, svc.ExternalIPs)
+	rq.Equal(map[string]string{"app": "api"}, svc.Selector)
+	rq.Len(svc.Ports, 1)
+	rq.Equal("http", svc.Ports[0].TargetPort)
+	rq.Equal(80, svc.Ports[0].Port)
+	rq.Equal(30080, svc.Ports[0].NodePort)
+}
+
+func Test_GetPodsBySelector(t *testing.T) {
+	t.Parallel()
+
+	client := Client{
+		set: fake.NewSimpleClientset(
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name: "api-1", Namespace: "default", Labels: map[string]string{"app": "api"},
+			}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name: "web-1", Namespace: "default", Labels: map[string]string{"app": "web"},
+			}},
+		),
+	}
+
+	t.Run("match", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		pods, err := client.GetPodsBySelector(context.Background(), "default", map[string]string{"app": "api"})
+		rq.NoError(err)
+		rq.Len(pods, 1)
+		rq.Equal("api-1", pods[0].Name)
+	})
+	t.Run("empty selector", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		pods, err := client.GetPodsBySelector(context.Background(), "default", nil)
+		rq.NoError(err)
+		rq.Empty(pods)
+	})
+}
@@ -0,0 +1,59 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (c *Client) GetStatefulSets(ctx context.Context, namespace string) ([]domain.StatefulSet, error) {
+	apiResp, err := c.clientSet().AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]domain.StatefulSet, len(apiResp.Items))
+	for i := range apiResp.Items {
+		sets[i] = toDomainStatefulSet(&apiResp.Items[i])
+	}
+
+	return sets, nil
+}
+
+func toDomainStatefulSet(s *appsv1.StatefulSet) domain.StatefulSet {
+	var sts domain.StatefulSet
+
+	replicas := int32(1) // default value if it's not set
+	if s.Spec.Replicas != nil {
+		replicas = *s.Spec.Replicas
+	}
+
+	sts.Name = s.Name
+	sts.Ready = fmt.Sprintf("%d/%d", s.Status.ReadyReplicas, replicas)
+	sts.Replicas = int(replicas)
+	sts.ReadyReplicas = int(s.Status.ReadyReplicas)
+	sts.CurrentReplicas = int(s.Status.CurrentReplicas)
+	sts.UpdatedReplicas = int(s.Status.UpdatedReplicas)
+	sts.ServiceName = s.Spec.ServiceName
+	sts.UpdateStrategy = string(s.Spec.UpdateStrategy.Type)
+	if ru := s.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		sts.Partition = int(*ru.Partition)
+	}
+	sts.PodManagementPolicy = string(s.Spec.PodManagementPolicy)
+	if s.Spec.Selector != nil {
+		sts.Selector = s.Spec.Selector.MatchLabels
+	}
+
+	age := time.Now().Unix() - s.GetCreationTimestamp().Unix()
+	sts.Age = ageToString(age)
+
+	sts.Labels = s.Labels
+	sts.Created = s.CreationTimestamp.Time
+	sts.Containers = toDomainContainers(s.Spec.Template.Spec.Containers)
+
+	return sts
+}
@@ -0,0 +1,52 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_GetStatefulSets(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	replicas := int32(3)
+	partition := int32(2)
+	client := Client{
+		set: fake.NewSimpleClientset(&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas:    &replicas,
+				ServiceName: "db-headless",
+				Selector:    &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+					Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+				},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "postgres", Image: "postgres:14"}}},
+				},
+			},
+			Status: appsv1.StatefulSetStatus{ReadyReplicas: 2},
+		}),
+	}
+
+	sets, err := client.GetStatefulSets(context.Background(), "default")
+	rq.NoError(err)
+	rq.Len(sets, 1)
+
+	sts := sets[0]
+	rq.Equal("db", sts.Name)
+	rq.Equal("2/3", sts.Ready)
+	rq.Equal(3, sts.Replicas)
+	rq.Equal(2, sts.Partition)
+	rq.Equal("db-headless", sts.ServiceName)
+	rq.Equal(map[string]string{"app": "db"}, sts.Selector)
+	rq.Len(sts.Containers, 1)
+	rq.Equal("postgres:14", sts.Containers[0].Image)
+}
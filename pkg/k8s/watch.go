@@ -0,0 +1,147 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/tty2/kubic/pkg/domain"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WatchPods runs an informer for pods of the namespace and sends every add/update/delete event to the returned channel.
+// Informer lists all the pods at start, so the first events are `Added` for already existing pods followed by `Synced`.
+// If pods can't be listed, `Failed` is sent on every attempt. The channel is closed when ctx is done.
+func (c *Client) WatchPods(ctx context.Context, namespace string) <-chan domain.PodChange {
+	changes := make(chan domain.PodChange)
+	send := func(change domain.PodChange) {
+		select {
+		case changes <- change:
+		case <-ctx.Done():
+		}
+	}
+
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			list, err := c.clientSet().CoreV1().Pods(namespace).List(ctx, opts)
+			if err != nil && ctx.Err() == nil {
+				send(domain.PodChange{Type: domain.Failed, Err: err})
+			}
+
+			return list, err
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return c.clientSet().CoreV1().Pods(namespace).Watch(ctx, opts)
+		},
+	}
+
+	runInformer(ctx, lw, &corev1.Pod{}, func(ct domain.ChangeType, obj interface{}) {
+		if ct == domain.Synced {
+			send(domain.PodChange{Type: ct})
+
+			return
+		}
+
+		p, ok := obj.(*corev1.Pod)
+		if !ok {
+			return
+		}
+
+		send(domain.PodChange{Type: ct, Pod: toDomainPod(p)})
+	}, func() {
+		close(changes)
+	})
+
+	return changes
+}
+
+// WatchDeployments runs an informer for deployments of the namespace and sends every add/update/delete event
+// to the returned channel. Like for pods, the initial list is followed by `Synced` and list errors are sent as `Failed`.
+// The channel is closed when ctx is done.
+func (c *Client) WatchDeployments(ctx context.Context, namespace string) <-chan domain.DeploymentChange {
+	changes := make(chan domain.DeploymentChange)
+	send := func(change domain.DeploymentChange) {
+		select {
+		case changes <- change:
+		case <-ctx.Done():
+		}
+	}
+
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			list, err := c.clientSet().AppsV1().Deployments(namespace).List(ctx, opts)
+			if err != nil && ctx.Err() == nil {
+				send(domain.DeploymentChange{Type: domain.Failed, Err: err})
+			}
+
+			return list, err
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return c.clientSet().AppsV1().Deployments(namespace).Watch(ctx, opts)
+		},
+	}
+
+	runInformer(ctx, lw, &appsv1.Deployment{}, func(ct domain.ChangeType, obj interface{}) {
+		if ct == domain.Synced {
+			send(domain.DeploymentChange{Type: ct})
+
+			return
+		}
+
+		d, ok := obj.(*appsv1.Deployment)
+		if !ok {
+			return
+		}
+
+		send(domain.DeploymentChange{Type: ct, Deployment: toDomainDeployment(d)})
+	}, func() {
+		close(changes)
+	})
+
+	return changes
+}
+
+// runInformer starts informer in a separate goroutine and calls `onStop` when ctx is done and informer is stopped.
+// Handlers are called synchronously from the informer loop, so when the informer is synced all the objects
+// of the initial list are already handled, `handle` is called with `Synced` and nil object then.
+// `onStop` is never called while `handle` is running.
+func runInformer(
+	ctx context.Context,
+	lw cache.ListerWatcher,
+	objType runtime.Object,
+	handle func(ct domain.ChangeType, obj interface{}),
+	onStop func(),
+) {
+	_, controller := cache.NewInformer(lw, objType, 0, cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			handle(domain.Added, obj)
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			handle(domain.Modified, obj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			// the object could be deleted while watch was disconnected, then we get the last known state
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			handle(domain.Deleted, obj)
+		},
+	})
+
+	stopped := make(chan struct{})
+	go func() {
+		controller.Run(ctx.Done())
+		close(stopped)
+	}()
+
+	go func() {
+		if cache.WaitForCacheSync(ctx.Done(), controller.HasSynced) {
+			handle(domain.Synced, nil)
+		}
+		<-stopped
+		onStop()
+	}()
+}
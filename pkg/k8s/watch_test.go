@@ -0,0 +1,185 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const watchTimeout = 5 * time.Second
+
+var errForbidden = errors.New("forbidden")
+
+func Test_WatchPods(t *testing.T) {
+	t.Parallel()
+
+	t.Run("events", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		set := fake.NewSimpleClientset()
+		watcher := watch.NewFake()
+		set.PrependWatchReactor("pods", k8stesting.DefaultWatchReactor(watcher, nil))
+		client := Client{set: set}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		changes := client.WatchPods(ctx, "default")
+		rq.Equal(domain.Synced, receive(t, changes).Type)
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-1", Namespace: "default"},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		}
+		watcher.Add(pod)
+
+		change := receive(t, changes)
+		rq.Equal(domain.Added, change.Type)
+		rq.Equal("app-1", change.Pod.Name)
+		rq.Equal("Pending", change.Pod.Status)
+
+		running := pod.DeepCopy()
+		running.Status.Phase = corev1.PodRunning
+		watcher.Modify(running)
+
+		change = receive(t, changes)
+		rq.Equal(domain.Modified, change.Type)
+		rq.Equal("Running", change.Pod.Status)
+
+		watcher.Delete(running)
+
+		change = receive(t, changes)
+		rq.Equal(domain.Deleted, change.Type)
+		rq.Equal("app-1", change.Pod.Name)
+	})
+
+	t.Run("existing pods", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		set := fake.NewSimpleClientset(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-1", Namespace: "default"},
+		})
+		client := Client{set: set}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		changes := client.WatchPods(ctx, "default")
+
+		change := receive(t, changes)
+		rq.Equal(domain.Added, change.Type)
+		rq.Equal("app-1", change.Pod.Name)
+
+		rq.Equal(domain.Synced, receive(t, changes).Type)
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		set := fake.NewSimpleClientset()
+		set.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errForbidden
+		})
+		client := Client{set: set}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		change := receive(t, client.WatchPods(ctx, "default"))
+		rq.Equal(domain.Failed, change.Type)
+		rq.ErrorIs(change.Err, errForbidden)
+	})
+
+	t.Run("closed on cancel", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		client := Client{set: fake.NewSimpleClientset()}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		changes := client.WatchPods(ctx, "default")
+		cancel()
+
+		select {
+		case _, ok := <-changes:
+			rq.False(ok)
+		case <-time.After(watchTimeout):
+			rq.Fail("channel is not closed")
+		}
+	})
+}
+
+func Test_WatchDeployments(t *testing.T) {
+	t.Parallel()
+
+	t.Run("events", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		set := fake.NewSimpleClientset()
+		watcher := watch.NewFake()
+		set.PrependWatchReactor("deployments", k8stesting.DefaultWatchReactor(watcher, nil))
+		client := Client{set: set}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		changes := client.WatchDeployments(ctx, "default")
+		rq.Equal(domain.Synced, receive(t, changes).Type)
+
+		dep := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+			Status:     appsv1.DeploymentStatus{Replicas: 2, ReadyReplicas: 1},
+		}
+		watcher.Add(dep)
+
+		change := receive(t, changes)
+		rq.Equal(domain.Added, change.Type)
+		rq.Equal("app", change.Deployment.Name)
+		rq.Equal("1/2", change.Deployment.Ready)
+
+		ready := dep.DeepCopy()
+		ready.Status.ReadyReplicas = 2
+		watcher.Modify(ready)
+
+		change = receive(t, changes)
+		rq.Equal(domain.Modified, change.Type)
+		rq.Equal("2/2", change.Deployment.Ready)
+
+		watcher.Delete(ready)
+
+		change = receive(t, changes)
+		rq.Equal(domain.Deleted, change.Type)
+	})
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v, ok := <-ch:
+		require.True(t, ok, "channel is closed")
+
+		return v
+	case <-time.After(watchTimeout):
+		require.FailNow(t, "no event received")
+	}
+
+	var zero T
+
+	return zero
+}
@@ -0,0 +1,69 @@
+/*
+Package banner keeps the error banner shown under the tabs. It shows the last error of the lists loading,
+the lists keep their previous data meanwhile.
+*/
+package banner
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+type Model struct {
+	app *shared.App
+	err *shared.LoadErrorMsg
+}
+
+func New(app *shared.App) *Model {
+	return &Model{
+		app: app,
+	}
+}
+
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// Shown returns true if there is an error to show.
+func (m *Model) Shown() bool {
+	return m.err != nil
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case shared.LoadErrorMsg:
+		m.err = &msg
+		m.resize()
+	case tea.KeyMsg:
+		if m.Shown() && key.Matches(msg, m.app.KeyMap.DismissError) {
+			m.err = nil
+			m.resize()
+		}
+	}
+
+	return m, nil
+}
+
+func (m *Model) View() string {
+	if m.err == nil {
+		return ""
+	}
+
+	text := fmt.Sprintf(" Error loading %s: %v (%s to dismiss)",
+		m.err.Tab, m.err.Err, m.app.KeyMap.DismissError.Keys()[0])
+
+	return m.app.Styles.WarningText.Copy().Bold(true).MaxWidth(m.app.GUI.ScreenWidth).Render(text)
+}
+
+// resize gives the banner line to the main content back when there is no error.
+func (m *Model) resize() {
+	if m.err != nil {
+		m.app.GUI.Areas.Banner.Height = shared.BannerHeight
+	} else {
+		m.app.GUI.Areas.Banner.Height = 0
+	}
+	m.app.ResizeAreas()
+}
@@ -0,0 +1,36 @@
+package cmdline
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type keyMap struct {
+	Submit    key.Binding
+	Cancel    key.Binding
+	Complete  key.Binding
+	Prev      key.Binding
+	Next      key.Binding
+	Backspace key.Binding
+}
+
+// nolint gochecknoglobals: used here on purpose
+var keys = keyMap{
+	Submit: key.NewBinding(
+		key.WithKeys("enter"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc"),
+	),
+	Complete: key.NewBinding(
+		key.WithKeys("tab"),
+	),
+	Prev: key.NewBinding(
+		key.WithKeys("up"),
+	),
+	Next: key.NewBinding(
+		key.WithKeys("down"),
+	),
+	Backspace: key.NewBinding(
+		key.WithKeys("backspace"),
+	),
+}
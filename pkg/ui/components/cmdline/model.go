@@ -0,0 +1,164 @@
+/*
+Package cmdline keeps the command line opened by `:` at the bottom of the screen.
+It jumps to the resources tabs (`:pods`, `:ns kube-system`) and runs the actions of the current tab (`:scale 3`).
+*/
+package cmdline
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+)
+
+const (
+	prefix = ":"
+	cursor = "_"
+	// matchesGap separates the typed line and the completion candidates.
+	matchesGap = "    "
+)
+
+// Runner runs the parsed command. The command line stays open and shows the error if it fails.
+type Runner func(cmd shared.Command) (tea.Cmd, error)
+
+// ArgsCompleter returns the values to complete the argument of the resource command, like namespaces names.
+type ArgsCompleter func(tab shared.TabItem) []string
+
+// Model is the command line. While it's open, it must receive all the keys.
+type Model struct {
+	app     *shared.App
+	run     Runner
+	args    ArgsCompleter
+	history shared.CommandHistory
+	value   string
+	// typed keeps the typed line while the history is walked.
+	typed string
+	// matches are the completion candidates of the last completion.
+	matches []string
+	err     error
+	open    bool
+}
+
+func New(app *shared.App, run Runner, args ArgsCompleter) *Model {
+	return &Model{
+		app:  app,
+		run:  run,
+		args: args,
+	}
+}
+
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// Open opens the empty command line.
+func (m *Model) Open() {
+	m.open = true
+	m.value = ""
+	m.reset()
+}
+
+// IsOpen returns true if the command line is shown.
+func (m *Model) IsOpen() bool {
+	return m.open
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.open {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, keys.Submit):
+		return m, m.submit()
+	case key.Matches(keyMsg, keys.Cancel):
+		m.open = false
+	case key.Matches(keyMsg, keys.Complete):
+		m.value, m.matches = shared.CompleteCommand(m.value, m.args)
+		m.err = nil
+	case key.Matches(keyMsg, keys.Prev):
+		if line, ok := m.history.Prev(); ok {
+			m.value = line
+		}
+	case key.Matches(keyMsg, keys.Next):
+		if line, ok := m.history.Next(); ok {
+			m.value = line
+		} else {
+			m.value = m.typed
+		}
+	case key.Matches(keyMsg, keys.Backspace):
+		// like in vim, backspace on the empty line closes it
+		if m.value == "" {
+			m.open = false
+
+			return m, nil
+		}
+		r := []rune(m.value)
+		m.value = string(r[:len(r)-1])
+		m.reset()
+		m.typed = m.value
+	case keyMsg.Type == tea.KeyRunes || keyMsg.Type == tea.KeySpace:
+		m.value += string(keyMsg.Runes)
+		m.reset()
+		m.typed = m.value
+	}
+
+	return m, nil
+}
+
+// submit runs the typed command and closes the command line if it's succeeded.
+func (m *Model) submit() tea.Cmd {
+	if strings.TrimSpace(m.value) == "" {
+		m.open = false
+
+		return nil
+	}
+
+	cmd, err := shared.ParseCommand(m.value)
+	if err != nil {
+		m.err = err
+
+		return nil
+	}
+
+	teaCmd, err := m.run(cmd)
+	if err != nil {
+		m.err = err
+
+		return nil
+	}
+
+	m.history.Add(m.value)
+	m.open = false
+
+	return teaCmd
+}
+
+// reset clears the completion and the error of the previous line, the history is walked from the end again.
+func (m *Model) reset() {
+	m.matches = nil
+	m.err = nil
+	m.history.Reset()
+}
+
+func (m *Model) View() string {
+	line := prefix + m.value + cursor
+	switch {
+	case m.err != nil:
+		line += matchesGap + m.app.Styles.WarningText.Render("Error: "+m.err.Error())
+	case len(m.matches) > 1:
+		line += matchesGap + m.app.Styles.InactiveText.Render(strings.Join(m.matches, " "))
+	}
+
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+	s.WriteString(m.app.Styles.MainText.Copy().MaxWidth(m.app.GUI.ScreenWidth).Render(line))
+
+	return m.app.Styles.HelpBar.
+		Height(m.app.GUI.Areas.HelpBar.Height).Render(s.String())
+}
@@ -0,0 +1,172 @@
+package configmaps
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	nameHeader        = "Name"
+	keysHeader        = "Keys"
+	ageHeader         = "Age"
+	minColumnGap      = "  "
+	nameColumnLen     = 30
+	keysColumnLen     = len(keysHeader)
+	tableHeaderHeight = 3
+	// hexPreviewLen is the max number of binary value bytes shown in the hex preview.
+	hexPreviewLen = 512
+	// keysOffset is the number of info lines before the keys list.
+	keysOffset = 1
+)
+
+// nolint gochecknoglobals: used here on purpose
+var boldText = lipgloss.NewStyle().Bold(true)
+
+type (
+	configMap struct {
+		Name       string
+		Keys       []string
+		Data       map[string]string
+		BinaryData map[string][]byte
+		Labels     map[string]string
+		Age        string
+		Created    time.Time
+		Styles     *themes.Styles
+	}
+)
+
+func newConfigMap(cm domain.ConfigMap) *configMap {
+	keys := make([]string, 0, len(cm.Data)+len(cm.BinaryData))
+	for k := range cm.Data {
+		keys = append(keys, k)
+	}
+	for k := range cm.BinaryData {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return &configMap{
+		Name:       cm.Name,
+		Keys:       keys,
+		Data:       cm.Data,
+		BinaryData: cm.BinaryData,
+		Labels:     cm.Labels,
+		Age:        cm.Age,
+		Created:    cm.Created,
+	}
+}
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+func (c *configMap) FilterValue() string { return shared.FilterValueWithLabels(c.Name, c.Labels) }
+func (c *configMap) Height() int         { return 1 }
+func (c *configMap) Spacing() int        { return 1 }
+func (c *configMap) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (c *configMap) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	cm, ok := listItem.(*configMap)
+	if !ok {
+		return
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(cm.Name, nameColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(len(cm.Keys)), keysColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(cm.Age)
+
+	rowString := row.String()
+
+	if index == m.Index() {
+		fmt.Fprint(w, c.Styles.SelectedText.Render(rowString))
+	} else {
+		fmt.Fprint(w, c.Styles.MainText.Render(rowString))
+	}
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(nameHeader)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(keysHeader)
+	header.WriteString(strings.Repeat(" ", keysColumnLen-len(keysHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(ageHeader)
+
+	return header.String()
+}
+
+// renderKeys renders the keys of the config map with their sizes.
+// Selected key is highlighted if selected is not negative.
+func (c *configMap) renderKeys(selected int) string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Keys"))
+	info.WriteString("\n")
+
+	if len(c.Keys) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString("<none>")
+
+		return info.String()
+	}
+
+	for i, k := range c.Keys {
+		line := minColumnGap + k + minColumnGap + c.keySize(k)
+		if i == selected {
+			line = c.Styles.SelectedText.Render(line)
+		}
+		info.WriteString(line)
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
+
+func (c *configMap) keySize(k string) string {
+	if b, ok := c.BinaryData[k]; ok {
+		return fmt.Sprintf("(binary, %d bytes)", len(b))
+	}
+
+	return fmt.Sprintf("(%d bytes)", len(c.Data[k]))
+}
+
+// renderValue renders the value of the key. Binary values are shown as size and hex dump of the first bytes.
+func (c *configMap) renderValue(k string) string {
+	b, ok := c.BinaryData[k]
+	if !ok {
+		return c.Data[k]
+	}
+
+	var value strings.Builder
+	value.WriteString(boldText.Render(fmt.Sprintf("Binary data, %d bytes", len(b))))
+	value.WriteString("\n\n")
+	if len(b) > hexPreviewLen {
+		value.WriteString(hex.Dump(b[:hexPreviewLen]))
+		value.WriteString("...")
+	} else {
+		value.WriteString(hex.Dump(b))
+	}
+
+	return value.String()
+}
@@ -0,0 +1,357 @@
+package configmaps
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+)
+
+type focused int
+
+const (
+	listInFocus focused = iota
+	keysInFocus
+	valueInFocus
+)
+
+type configMapsRepo interface {
+	GetConfigMaps(ctx context.Context, namespace string) ([]domain.ConfigMap, error)
+}
+
+// listMsg is a result of the config maps loading.
+type listMsg struct {
+	generation int
+	configMaps []domain.ConfigMap
+	err        error
+}
+
+// Model for config maps.
+type Model struct {
+	app     *shared.App
+	list    list.Model
+	repo    configMapsRepo
+	focused focused
+	infobar *infobar.Model
+	loader  *shared.Loader
+	// key is an index of the selected key of the current config map.
+	key int
+}
+
+func New(app *shared.App, repo configMapsRepo) (*Model, error) {
+	m := Model{
+		repo:    repo,
+		app:     app,
+		infobar: infobar.New(),
+		loader:  shared.NewLoader(app.Styles.NamespaceSign),
+	}
+
+	itemsModel := list.New([]list.Item{}, &configMap{
+		Styles: app.Styles,
+	}, 0, 0)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
+	itemsModel.SetShowTitle(false)
+	itemsModel.SetShowStatusBar(false)
+	itemsModel.SetShowHelp(false)
+	itemsModel.Paginator.Type = paginator.Dots
+	m.list = itemsModel
+
+	m.setInfoBarHeight()
+
+	return &m, nil
+}
+
+func (m *Model) Init() tea.Cmd {
+	return m.load()
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case listMsg:
+		return m, m.applyList(msg)
+	case shared.ContextChangedMsg:
+		// the list is loaded again on the namespace change which follows the switch
+		shared.ClearList(&m.list, m.loader)
+		m.resetFocus()
+		m.setInfoContent()
+
+		return m, cmd
+	case shared.NamespaceChangedMsg:
+		m.resetFocus()
+
+		return m, m.load()
+	}
+
+	if cmd = m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.app.KeyMap.FocusRight),
+			m.focused == keysInFocus && key.Matches(msg, m.app.KeyMap.Select):
+			m.changeFocusRight()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.FocusLeft):
+			m.changeFocusLeft()
+
+			return m, cmd
+		case m.focused == keysInFocus && key.Matches(msg, m.app.KeyMap.Up):
+			m.selectKey(m.key - 1)
+
+			return m, cmd
+		case m.focused == keysInFocus && key.Matches(msg, m.app.KeyMap.Down):
+			m.selectKey(m.key + 1)
+
+			return m, cmd
+		}
+	}
+
+	if m.focused == listInFocus {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+	} else {
+		_, cmd = m.infobar.Update(msg)
+	}
+
+	return m, cmd
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+func (m *Model) View() string {
+	m.setInfoBarHeight()
+
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(), m.list, m.loader))
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+
+	s.WriteString(
+		m.app.Styles.InitStyle.Render(
+			lipgloss.JoinHorizontal(
+				lipgloss.Top,
+				m.app.Styles.ListRightBorder.Render(m.list.View()),
+				m.renderInfoBar(),
+			),
+		))
+
+	return s.String()
+}
+
+// load loads config maps of the current namespace in background, the result is applied by `applyList`.
+func (m *Model) load() tea.Cmd {
+	namespace := m.app.CurrentNamespace
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	return tea.Batch(start, func() tea.Msg {
+		cms, err := m.repo.GetConfigMaps(context.Background(), namespace)
+
+		return listMsg{generation: generation, configMaps: cms, err: err}
+	})
+}
+
+// applyList shows the loaded config maps. The previous list is kept if they can't be loaded.
+func (m *Model) applyList(msg listMsg) tea.Cmd {
+	// namespace or context has been changed since the list was requested
+	if !m.loader.Done(msg.generation) {
+		return nil
+	}
+
+	if msg.err != nil {
+		return shared.LoadError(shared.ConfigMapsTab, msg.err)
+	}
+
+	items := make([]list.Item, len(msg.configMaps))
+	for i := range msg.configMaps {
+		items[i] = newConfigMap(msg.configMaps[i])
+	}
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	m.setInfoContent()
+
+	return nil
+}
+
+func (m *Model) changeFocusRight() {
+	cm := m.getCurrentConfigMap()
+	if cm == nil {
+		return
+	}
+
+	switch m.focused {
+	case listInFocus:
+		m.focused = keysInFocus
+		m.key = 0
+	case keysInFocus:
+		if len(cm.Keys) == 0 {
+			return
+		}
+		m.focused = valueInFocus
+	default:
+		return
+	}
+
+	m.setInfoContent()
+	m.infobar.ResetIndent()
+	m.infobar.ResetView()
+}
+
+func (m *Model) changeFocusLeft() {
+	switch m.focused {
+	case valueInFocus:
+		m.focused = keysInFocus
+		m.setInfoContent()
+		m.infobar.ResetIndent()
+		m.infobar.ResetView()
+		m.infobar.ShowLine(keysOffset + m.key)
+	case keysInFocus:
+		m.focused = listInFocus
+		m.setInfoContent()
+		m.infobar.ResetIndent()
+		m.infobar.ResetView()
+	}
+}
+
+// selectKey moves the keys cursor and scrolls the info bar to keep the selected key visible.
+func (m *Model) selectKey(i int) {
+	cm := m.getCurrentConfigMap()
+	if cm == nil || i < 0 || i >= len(cm.Keys) {
+		return
+	}
+
+	m.key = i
+	m.setInfoContent()
+	m.infobar.ShowLine(keysOffset + m.key)
+}
+
+func (m *Model) resetFocus() {
+	m.focused = listInFocus
+	m.key = 0
+	m.infobar.ResetIndent()
+	m.list.ResetSelected()
+}
+
+func (m *Model) renderInfoBar() string {
+	infoData := m.infobar.View()
+
+	if m.focused == listInFocus {
+		infoData = m.app.Styles.InactiveText.Render(infoData)
+	}
+
+	info := lipgloss.JoinVertical(lipgloss.Left,
+		m.renderInfoBarTabs(),
+		infoData,
+	)
+
+	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
+}
+
+func (m *Model) getCurrentConfigMap() *configMap {
+	item := m.list.SelectedItem()
+	cm, ok := item.(*configMap)
+	if !ok {
+		return nil
+	}
+
+	return cm
+}
+
+func (m *Model) renderInfoBarTabs() string {
+	tabs := getInfoTabs()
+	titles := make([]string, len(tabs))
+	for i := range tabs {
+		if m.focused == tabs[i] {
+			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i].String())
+
+			continue
+		}
+		titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i].String())
+	}
+
+	titlesStr := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		titles...,
+	)
+
+	gap := m.app.Styles.InfoGap.Render(
+		strings.Repeat(" ", shared.Max(0, m.app.GUI.ScreenWidth-lipgloss.Width(titlesStr))),
+	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, titlesStr, gap)
+}
+
+func (m *Model) setInfoContent() {
+	cm := m.getCurrentConfigMap()
+	if cm == nil {
+		m.infobar.SetContent("")
+
+		return
+	}
+	cm.Styles = m.app.Styles
+
+	switch m.focused {
+	case listInFocus:
+		m.infobar.SetContent(cm.renderKeys(-1))
+	case keysInFocus:
+		m.infobar.SetContent(cm.renderKeys(m.key))
+	case valueInFocus:
+		if m.key >= len(cm.Keys) {
+			m.infobar.SetContent("")
+
+			return
+		}
+		m.infobar.SetContent(cm.renderValue(cm.Keys[m.key]))
+	}
+}
+
+func (m *Model) setInfoBarHeight() {
+	m.infobar.SetWH(
+		m.app.InfoBarWidth(getHeader()),
+		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+	)
+	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
+}
+
+func getInfoTabs() []focused {
+	return []focused{
+		keysInFocus,
+		valueInFocus,
+	}
+}
+
+func (f focused) String() string {
+	switch f {
+	case keysInFocus:
+		return "Keys"
+	case valueInFocus:
+		return "Value"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,41 @@
+package contexts
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+// CommandArgs returns the names to complete `:ctx` command.
+func (m *Model) CommandArgs() []string {
+	items := m.list.Items()
+	names := make([]string, 0, len(items))
+	for i := range items {
+		if c, ok := items[i].(*kubeContext); ok {
+			names = append(names, c.Name)
+		}
+	}
+
+	return names
+}
+
+// RunCommand switches to the context typed in `:ctx name` command like it's selected in the list.
+func (m *Model) RunCommand(cmd shared.Command) (tea.Cmd, error) {
+	if tab, ok := cmd.Tab(); !ok || tab != shared.ContextsTab {
+		return nil, fmt.Errorf("%w: %s", shared.ErrCommandNotAvailable, cmd.Name)
+	}
+
+	items := m.list.Items()
+	for i := range items {
+		if c, ok := items[i].(*kubeContext); ok && c.Name == cmd.Arg() {
+			// the context may be hidden by the filter
+			m.list.ResetFilter()
+			m.list.Select(i)
+
+			return m.switchContext(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("context %s not found", cmd.Arg())
+}
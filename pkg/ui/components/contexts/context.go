@@ -0,0 +1,99 @@
+package contexts
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	active             = "✔"
+	inactive           = " "
+	nameHeader         = "Name"
+	clusterHeader      = "Cluster"
+	userHeader         = "User"
+	namespaceHeader    = "Namespace"
+	minColumnGap       = "  "
+	nameColumnLen      = 30
+	clusterColumnLen   = 30
+	userColumnLen      = 20
+	namespaceColumnLen = 20
+	tableHeaderHeight  = 3
+)
+
+type (
+	kubeContext struct {
+		Name      string
+		Cluster   string
+		User      string
+		Namespace string
+		Active    bool
+		Styles    *themes.Styles
+	}
+)
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+func (c *kubeContext) FilterValue() string { return c.Name }
+func (c *kubeContext) Height() int         { return 1 }
+func (c *kubeContext) Spacing() int        { return 1 }
+func (c *kubeContext) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (c *kubeContext) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	s, ok := listItem.(*kubeContext)
+	if !ok {
+		return
+	}
+
+	sign := inactive
+	if s.Active {
+		sign = c.Styles.NamespaceSign.Render(active)
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(s.Name, nameColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(s.Cluster, clusterColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(s.User, userColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(s.Namespace, namespaceColumnLen))
+
+	rowInfo := row.String()
+
+	if index == m.Index() {
+		fmt.Fprintf(w, "%s %s", sign, c.Styles.SelectedText.Render(rowInfo))
+	} else {
+		fmt.Fprintf(w, "%s %s", sign, c.Styles.MainText.Render(rowInfo))
+	}
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(nameHeader)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(clusterHeader)
+	header.WriteString(strings.Repeat(" ", clusterColumnLen-len(clusterHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(userHeader)
+	header.WriteString(strings.Repeat(" ", userColumnLen-len(userHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(namespaceHeader)
+
+	return header.String()
+}
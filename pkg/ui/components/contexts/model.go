@@ -0,0 +1,198 @@
+package contexts
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+)
+
+type contextsRepo interface {
+	GetContexts() ([]domain.Context, error)
+	SwitchContext(name string) error
+}
+
+// switchMsg is a result of the context switch.
+type switchMsg struct {
+	context   string
+	namespace string
+	err       error
+}
+
+// Model for the kubeconfig contexts.
+// Selected context replaces the connection of the client, then all the components are reloaded
+// on `shared.ContextChangedMsg`. Contexts are read from the kubeconfig, they are not loaded from the cluster.
+type Model struct {
+	app  *shared.App
+	list list.Model
+	repo contextsRepo
+	// switching is the name of the context the client is being switched to.
+	switching string
+	// err is the error of the last switch or of the contexts loading.
+	err error
+}
+
+func New(app *shared.App, repo contextsRepo) (*Model, error) {
+	m := Model{
+		repo: repo,
+		app:  app,
+	}
+
+	itemsModel := list.New([]list.Item{}, &kubeContext{
+		Styles: app.Styles,
+	}, 0, 0)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
+	itemsModel.SetShowTitle(false)
+	itemsModel.SetShowStatusBar(false)
+	itemsModel.SetShowHelp(false)
+	itemsModel.Paginator.Type = paginator.Dots
+	m.list = itemsModel
+	m.UpdateList()
+
+	// kubic starts in the namespace of the context like kubectl does
+	m.app.CurrentNamespace = shared.DefaultNamespace
+	if c, ok := m.getActive(); ok && c.Namespace != "" {
+		m.app.CurrentNamespace = c.Namespace
+	}
+
+	return &m, nil
+}
+
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(switchMsg); ok {
+		return m, m.applySwitch(msg)
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		if m.list.FilterState() != list.Filtering && key.Matches(msg, m.app.KeyMap.Select) {
+			return m, m.switchContext()
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+
+	return m, cmd
+}
+
+func (m *Model) View() string {
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(), m.list, nil))
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+
+	listHeight := m.app.GUI.Areas.MainContent.Height - tableHeaderHeight
+	if status := m.renderStatus(); status != "" {
+		s.WriteString(status)
+		s.WriteString("\n")
+		listHeight--
+	}
+
+	m.list.SetHeight(listHeight)
+	s.WriteString(m.app.Styles.InitStyle.Render(m.list.View()))
+
+	return s.String()
+}
+
+func (m *Model) UpdateList() {
+	contexts, err := m.repo.GetContexts()
+	if err != nil {
+		m.err = err
+
+		return
+	}
+
+	items := make([]list.Item, len(contexts))
+	for i := range contexts {
+		items[i] = &kubeContext{
+			Name:      contexts[i].Name,
+			Cluster:   contexts[i].Cluster,
+			User:      contexts[i].User,
+			Namespace: contexts[i].Namespace,
+			Active:    contexts[i].Current,
+		}
+		if contexts[i].Current {
+			m.app.CurrentContext = contexts[i].Name
+		}
+	}
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+}
+
+func (m *Model) getActive() (*kubeContext, bool) {
+	items := m.list.Items()
+	for i := range items {
+		if c, ok := items[i].(*kubeContext); ok && c.Active {
+			return c, true
+		}
+	}
+
+	return nil, false
+}
+
+// switchContext switches the client to the selected context in background.
+func (m *Model) switchContext() tea.Cmd {
+	c, ok := m.list.SelectedItem().(*kubeContext)
+	if !ok || m.switching != "" {
+		return nil
+	}
+
+	m.switching = c.Name
+	m.err = nil
+	name, namespace := c.Name, c.Namespace
+
+	return func() tea.Msg {
+		if err := m.repo.SwitchContext(name); err != nil {
+			return switchMsg{context: name, err: err}
+		}
+
+		return switchMsg{context: name, namespace: namespace}
+	}
+}
+
+func (m *Model) applySwitch(msg switchMsg) tea.Cmd {
+	m.switching = ""
+	if msg.err != nil {
+		m.err = msg.err
+
+		return nil
+	}
+
+	m.app.CurrentContext = msg.context
+	// the namespace of the context is opened if it's set, default namespace otherwise like kubectl does,
+	// the namespaces list decides if the cluster doesn't have it
+	m.app.CurrentNamespace = shared.DefaultNamespace
+	if msg.namespace != "" {
+		m.app.CurrentNamespace = msg.namespace
+	}
+	m.UpdateList()
+
+	return shared.ContextChanged
+}
+
+func (m *Model) renderStatus() string {
+	switch {
+	case m.switching != "":
+		return m.app.Styles.NamespaceSign.Render("Switching to " + m.switching + "...")
+	case m.err != nil:
+		return m.app.Styles.SelectedText.Render("Error: " + m.err.Error())
+	default:
+		return ""
+	}
+}
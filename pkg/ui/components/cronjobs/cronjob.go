@@ -0,0 +1,218 @@
+package cronjobs
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	nameHeader            = "Name"
+	scheduleHeader        = "Schedule"
+	suspendHeader         = "Suspend"
+	activeHeader          = "Active"
+	lastScheduleHeader    = "Last Schedule"
+	ageHeader             = "Age"
+	minColumnGap          = "  "
+	nameColumnLen         = 20
+	scheduleColumnLen     = 15
+	suspendColumnLen      = len(suspendHeader)
+	activeColumnLen       = len(activeHeader)
+	lastScheduleColumnLen = len(lastScheduleHeader)
+	jobNameColumnLen      = 30
+	completionsColumnLen  = 7
+	durationColumnLen     = 8
+	statusColumnLen       = 8
+	tableHeaderHeight     = 3
+	never                 = "<none>"
+)
+
+// nolint gochecknoglobals: used here on purpose
+var boldText = lipgloss.NewStyle().Bold(true)
+
+type (
+	cronJob struct {
+		Name         string
+		Schedule     string
+		Suspend      bool
+		LastSchedule time.Time
+		Active       int
+		Age          string
+		Labels       map[string]string
+		Created      time.Time
+		Containers   []domain.Container
+		Styles       *themes.Styles
+	}
+)
+
+func newCronJob(cj domain.CronJob) *cronJob {
+	return &cronJob{
+		Name:         cj.Name,
+		Schedule:     cj.Schedule,
+		Suspend:      cj.Suspend,
+		LastSchedule: cj.LastSchedule,
+		Active:       cj.Active,
+		Age:          cj.Age,
+		Labels:       cj.Labels,
+		Created:      cj.Created,
+		Containers:   cj.Containers,
+	}
+}
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+func (c *cronJob) FilterValue() string { return shared.FilterValueWithLabels(c.Name, c.Labels) }
+func (c *cronJob) Height() int         { return 1 }
+func (c *cronJob) Spacing() int        { return 1 }
+func (c *cronJob) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (c *cronJob) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	cj, ok := listItem.(*cronJob)
+	if !ok {
+		return
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(cj.Name, nameColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(cj.Schedule, scheduleColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.FormatBool(cj.Suspend), suspendColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(cj.Active), activeColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(cj.lastScheduleAgo(), lastScheduleColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(cj.Age)
+
+	rowString := row.String()
+
+	if index == m.Index() {
+		fmt.Fprint(w, c.Styles.SelectedText.Render(rowString))
+	} else {
+		fmt.Fprint(w, c.Styles.MainText.Render(rowString))
+	}
+}
+
+func (c *cronJob) lastScheduleAgo() string {
+	if c.LastSchedule.IsZero() {
+		return never
+	}
+
+	return time.Since(c.LastSchedule).Truncate(time.Second).String()
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(nameHeader)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(scheduleHeader)
+	header.WriteString(strings.Repeat(" ", scheduleColumnLen-len(scheduleHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(suspendHeader)
+	header.WriteString(minColumnGap)
+
+	header.WriteString(activeHeader)
+	header.WriteString(minColumnGap)
+
+	header.WriteString(lastScheduleHeader)
+	header.WriteString(minColumnGap)
+
+	header.WriteString(ageHeader)
+
+	return header.String()
+}
+
+func (c *cronJob) renderInfo(jobs []domain.Job) string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Name"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(c.Name)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Created"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(c.Created.Format(shared.TimeFormat))
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("Schedule"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(c.Schedule)
+	if c.Suspend {
+		info.WriteString(" (suspended)")
+	}
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("Last Schedule"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	if c.LastSchedule.IsZero() {
+		info.WriteString(never)
+	} else {
+		info.WriteString(c.LastSchedule.Format(shared.TimeFormat))
+	}
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("Active Jobs"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(strconv.Itoa(c.Active))
+	info.WriteString("\n")
+
+	info.WriteString(renderJobs(jobs))
+	info.WriteString(shared.RenderContainersInfo(c.Containers))
+
+	return info.String()
+}
+
+func renderJobs(jobs []domain.Job) string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Jobs"))
+	info.WriteString("\n")
+
+	if len(jobs) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString(never)
+		info.WriteString("\n")
+
+		return info.String()
+	}
+
+	for i := range jobs {
+		info.WriteString(minColumnGap)
+		info.WriteString(shared.GetTextWithLen(jobs[i].Name, jobNameColumnLen))
+		info.WriteString(minColumnGap)
+		info.WriteString(shared.GetTextWithLen(jobs[i].Completions, completionsColumnLen))
+		info.WriteString(minColumnGap)
+		info.WriteString(shared.GetTextWithLen(jobs[i].Duration, durationColumnLen))
+		info.WriteString(minColumnGap)
+		info.WriteString(shared.GetTextWithLen(jobs[i].Status, statusColumnLen))
+		info.WriteString(minColumnGap)
+		info.WriteString(jobs[i].Age)
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
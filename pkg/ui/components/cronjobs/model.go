@@ -0,0 +1,402 @@
+package cronjobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/confirm"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+)
+
+type focused int
+
+const (
+	listInFocus focused = iota
+	infoInFocus
+)
+
+type cronJobsRepo interface {
+	GetCronJobs(ctx context.Context, namespace string) ([]domain.CronJob, error)
+	GetJobs(ctx context.Context, namespace string) ([]domain.Job, error)
+	TriggerCronJob(ctx context.Context, namespace, name string) (string, error)
+	SetCronJobSuspend(ctx context.Context, namespace, name string, suspend bool) error
+}
+
+// actionMsg is a result of the action with a cron job.
+type actionMsg struct {
+	text string
+	err  error
+}
+
+// listMsg is a result of the cron jobs loading, jobs are loaded with them to show the jobs of every cron job.
+type listMsg struct {
+	generation int
+	cronJobs   []domain.CronJob
+	jobs       []domain.Job
+	err        error
+}
+
+// Model for cron jobs.
+type Model struct {
+	app     *shared.App
+	list    list.Model
+	repo    cronJobsRepo
+	focused focused
+	infobar *infobar.Model
+	loader  *shared.Loader
+	confirm *confirm.Model
+	// jobs are all the jobs of the namespace, they are shown for the owner cron job.
+	jobs []domain.Job
+	// status is the result of the last action.
+	status *actionMsg
+}
+
+func New(app *shared.App, repo cronJobsRepo) (*Model, error) {
+	m := Model{
+		repo:    repo,
+		app:     app,
+		infobar: infobar.New(),
+		loader:  shared.NewLoader(app.Styles.NamespaceSign),
+		confirm: confirm.New(),
+	}
+
+	itemsModel := list.New([]list.Item{}, &cronJob{
+		Styles: app.Styles,
+	}, 0, 0)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
+	itemsModel.SetShowTitle(false)
+	itemsModel.SetShowStatusBar(false)
+	itemsModel.SetShowHelp(false)
+	itemsModel.Paginator.Type = paginator.Dots
+	m.list = itemsModel
+
+	m.setInfoBarHeight()
+
+	return &m, nil
+}
+
+func (m *Model) Init() tea.Cmd {
+	return m.load()
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case listMsg:
+		return m, m.applyList(msg)
+	case shared.ContextChangedMsg:
+		// the list is loaded again on the namespace change which follows the switch
+		shared.ClearList(&m.list, m.loader)
+		m.resetFocus()
+		m.setInfoContent()
+
+		return m, cmd
+	case shared.NamespaceChangedMsg:
+		m.resetFocus()
+
+		return m, m.load()
+	case actionMsg:
+		m.status = &msg
+		m.setInfoContent()
+
+		return m, m.load()
+	}
+
+	if cmd = m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	if m.confirm.Open() {
+		_, cmd = m.confirm.Update(msg)
+
+		return m, cmd
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.app.KeyMap.FocusRight):
+			m.changeFocusRight()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.FocusLeft):
+			m.changeFocusLeft()
+			m.infobar.ResetView()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.TriggerCronJob):
+			m.askTrigger()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.SuspendCronJob):
+			m.askSuspend()
+
+			return m, cmd
+		}
+	}
+
+	if m.listInFocus() {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+	} else {
+		_, cmd = m.infobar.Update(msg)
+	}
+
+	return m, cmd
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+// DialogOpen returns true if the confirmation dialog waits for the answer.
+func (m *Model) DialogOpen() bool {
+	return m.confirm.Open()
+}
+
+func (m *Model) View() string {
+	m.setInfoBarHeight()
+
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(), m.list, m.loader))
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+
+	s.WriteString(
+		m.app.Styles.InitStyle.Render(
+			lipgloss.JoinHorizontal(
+				lipgloss.Top,
+				m.app.Styles.ListRightBorder.Render(m.list.View()),
+				m.renderInfoBar(),
+			),
+		))
+
+	return s.String()
+}
+
+// load loads cron jobs and jobs of the current namespace in background, the result is applied by `applyList`.
+func (m *Model) load() tea.Cmd {
+	namespace := m.app.CurrentNamespace
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	return tea.Batch(start, func() tea.Msg {
+		cronJobs, err := m.repo.GetCronJobs(context.Background(), namespace)
+		if err != nil {
+			return listMsg{generation: generation, err: err}
+		}
+
+		jobs, err := m.repo.GetJobs(context.Background(), namespace)
+
+		return listMsg{generation: generation, cronJobs: cronJobs, jobs: jobs, err: err}
+	})
+}
+
+// applyList shows the loaded cron jobs. The previous list is kept if they can't be loaded.
+func (m *Model) applyList(msg listMsg) tea.Cmd {
+	// namespace or context has been changed since the list was requested
+	if !m.loader.Done(msg.generation) {
+		return nil
+	}
+
+	if msg.err != nil {
+		return shared.LoadError(shared.CronJobsTab, msg.err)
+	}
+	m.jobs = msg.jobs
+
+	items := make([]list.Item, len(msg.cronJobs))
+	for i := range msg.cronJobs {
+		items[i] = newCronJob(msg.cronJobs[i])
+	}
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	m.setInfoContent()
+
+	return nil
+}
+
+func (m *Model) askTrigger() {
+	cj := m.getCurrentCronJob()
+	if cj == nil {
+		return
+	}
+
+	namespace, name := m.app.CurrentNamespace, cj.Name
+	m.confirm.Ask(fmt.Sprintf("Create a job from cron job %s now?", name), func() tea.Msg {
+		job, err := m.repo.TriggerCronJob(context.Background(), namespace, name)
+		if err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("job %s created", job)}
+	})
+}
+
+func (m *Model) askSuspend() {
+	cj := m.getCurrentCronJob()
+	if cj == nil {
+		return
+	}
+
+	action := "Suspend"
+	if cj.Suspend {
+		action = "Resume"
+	}
+
+	namespace, name, suspend := m.app.CurrentNamespace, cj.Name, !cj.Suspend
+	m.confirm.Ask(fmt.Sprintf("%s cron job %s?", action, name), func() tea.Msg {
+		if err := m.repo.SetCronJobSuspend(context.Background(), namespace, name, suspend); err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("cron job %s: suspend is %t", name, suspend)}
+	})
+}
+
+func (m *Model) changeFocusRight() {
+	if m.listInFocus() {
+		m.focused = infoInFocus
+	}
+}
+
+func (m *Model) changeFocusLeft() {
+	if m.infoInFocus() {
+		m.focused = listInFocus
+	}
+}
+
+func (m *Model) listInFocus() bool {
+	return m.focused == listInFocus
+}
+
+func (m *Model) infoInFocus() bool {
+	return m.focused == infoInFocus
+}
+
+func (m *Model) resetFocus() {
+	m.focused = listInFocus
+	m.status = nil
+	m.confirm.Close()
+	m.infobar.ResetIndent()
+	m.list.ResetSelected()
+}
+
+func (m *Model) renderInfoBar() string {
+	if m.confirm.Open() {
+		return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(
+			m.confirm.View(
+				m.app.InfoBarWidth(getHeader()),
+				m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+				m.app.Styles.MainText,
+			),
+		)
+	}
+
+	infoData := m.infobar.View()
+
+	if !m.infoInFocus() {
+		infoData = m.app.Styles.InactiveText.Render(infoData)
+	}
+
+	info := lipgloss.JoinVertical(lipgloss.Left,
+		m.renderInfoBarTabs(),
+		infoData,
+	)
+
+	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
+}
+
+func (m *Model) getCurrentCronJob() *cronJob {
+	item := m.list.SelectedItem()
+	cj, ok := item.(*cronJob)
+	if !ok {
+		return nil
+	}
+
+	return cj
+}
+
+func (m *Model) renderInfoBarTabs() string {
+	tabs := getInfoTabs()
+	titles := make([]string, len(tabs))
+	for i := range tabs {
+		if m.infoInFocus() {
+			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i])
+		} else {
+			titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i])
+		}
+	}
+
+	titlesStr := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		titles...,
+	)
+
+	gap := m.app.Styles.InfoGap.Render(
+		strings.Repeat(" ", shared.Max(0, m.app.GUI.ScreenWidth-lipgloss.Width(titlesStr))),
+	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, titlesStr, gap)
+}
+
+func (m *Model) setInfoContent() {
+	cj := m.getCurrentCronJob()
+	if cj == nil {
+		m.infobar.SetContent(m.renderStatus())
+
+		return
+	}
+	cj.Styles = m.app.Styles
+
+	var jobs []domain.Job
+	for i := range m.jobs {
+		if m.jobs[i].CronJob == cj.Name {
+			jobs = append(jobs, m.jobs[i])
+		}
+	}
+
+	m.infobar.SetContent(m.renderStatus() + cj.renderInfo(jobs))
+}
+
+func (m *Model) renderStatus() string {
+	switch {
+	case m.status == nil:
+		return ""
+	case m.status.err != nil:
+		return m.app.Styles.SelectedText.Render("Error: "+m.status.err.Error()) + "\n"
+	default:
+		return m.app.Styles.NamespaceSign.Render(m.status.text) + "\n"
+	}
+}
+
+func (m *Model) setInfoBarHeight() {
+	m.infobar.SetWH(
+		m.app.InfoBarWidth(getHeader()),
+		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+	)
+	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
+}
+
+func getInfoTabs() []string {
+	return []string{"Info"}
+}
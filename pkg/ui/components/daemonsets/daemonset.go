@@ -0,0 +1,205 @@
+package daemonsets
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	nameHeader         = "Name"
+	desiredHeader      = "Desired"
+	currentHeader      = "Current"
+	readyHeader        = "Ready"
+	upToDateHeader     = "UpToDate"
+	availableHeader    = "Available"
+	ageHeader          = "Age"
+	minColumnGap       = "  "
+	nameColumnLen      = 20
+	desiredColumnLen   = len(desiredHeader)
+	currentColumnLen   = len(currentHeader)
+	readyColumnLen     = len(readyHeader)
+	upToDateColumnLen  = len(upToDateHeader)
+	availableColumnLen = len(availableHeader)
+	tableHeaderHeight  = 3
+)
+
+// nolint gochecknoglobals: used here on purpose
+var boldText = lipgloss.NewStyle().Bold(true)
+
+type (
+	daemonSet struct {
+		Name           string
+		Desired        int
+		Scheduled      int
+		Misscheduled   int
+		Ready          int
+		UpToDate       int
+		Available      int
+		UpdateStrategy string
+		NodeSelector   map[string]string
+		Age            string
+		Labels         map[string]string
+		Created        time.Time
+		Containers     []domain.Container
+		Styles         *themes.Styles
+	}
+)
+
+func newDaemonSet(d domain.DaemonSet) *daemonSet {
+	return &daemonSet{
+		Name:           d.Name,
+		Desired:        d.Desired,
+		Scheduled:      d.Scheduled,
+		Misscheduled:   d.Misscheduled,
+		Ready:          d.Ready,
+		UpToDate:       d.UpToDate,
+		Available:      d.Available,
+		UpdateStrategy: d.UpdateStrategy,
+		NodeSelector:   d.NodeSelector,
+		Age:            d.Age,
+		Labels:         d.Labels,
+		Created:        d.Created,
+		Containers:     d.Containers,
+	}
+}
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+func (d *daemonSet) FilterValue() string { return shared.FilterValueWithLabels(d.Name, d.Labels) }
+func (d *daemonSet) Height() int         { return 1 }
+func (d *daemonSet) Spacing() int        { return 1 }
+func (d *daemonSet) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (d *daemonSet) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	ds, ok := listItem.(*daemonSet)
+	if !ok {
+		return
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(ds.Name, nameColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(ds.Desired), desiredColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(ds.Scheduled), currentColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(ds.Ready), readyColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(ds.UpToDate), upToDateColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(ds.Available), availableColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(ds.Age)
+
+	rowString := row.String()
+
+	if index == m.Index() {
+		fmt.Fprint(w, d.Styles.SelectedText.Render(rowString))
+	} else {
+		fmt.Fprint(w, d.Styles.MainText.Render(rowString))
+	}
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(nameHeader)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(desiredHeader)
+	header.WriteString(minColumnGap)
+
+	header.WriteString(currentHeader)
+	header.WriteString(minColumnGap)
+
+	header.WriteString(readyHeader)
+	header.WriteString(minColumnGap)
+
+	header.WriteString(upToDateHeader)
+	header.WriteString(minColumnGap)
+
+	header.WriteString(availableHeader)
+	header.WriteString(minColumnGap)
+
+	header.WriteString(ageHeader)
+
+	return header.String()
+}
+
+func (d *daemonSet) renderInfo() string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Name"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(d.Name)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Created"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(d.Created.Format(shared.TimeFormat))
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Labels"))
+	info.WriteString("\n")
+
+	for k, v := range d.Labels {
+		info.WriteString(minColumnGap)
+		info.WriteString(k)
+		info.WriteString(": ")
+		info.WriteString(v)
+		info.WriteString("\n")
+	}
+
+	info.WriteString(boldText.Render("Nodes"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Desired: %d\n", d.Desired))
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Scheduled: %d\n", d.Scheduled))
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Misscheduled: %d\n", d.Misscheduled))
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Ready: %d\n", d.Ready))
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Up to date: %d\n", d.UpToDate))
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Available: %d\n", d.Available))
+
+	info.WriteString(boldText.Render("Update Strategy"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(d.UpdateStrategy)
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("Node Selector"))
+	info.WriteString("\n")
+	for k, v := range d.NodeSelector {
+		info.WriteString(minColumnGap)
+		info.WriteString(k)
+		info.WriteString("=")
+		info.WriteString(v)
+		info.WriteString("\n")
+	}
+
+	info.WriteString(shared.RenderContainersInfo(d.Containers))
+
+	return info.String()
+}
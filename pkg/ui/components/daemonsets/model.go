@@ -0,0 +1,285 @@
+package daemonsets
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+)
+
+type focused int
+
+const (
+	listInFocus focused = iota
+	infoInFocus
+)
+
+type daemonSetsRepo interface {
+	GetDaemonSets(ctx context.Context, namespace string) ([]domain.DaemonSet, error)
+}
+
+// listMsg is a result of the daemon sets loading.
+type listMsg struct {
+	generation int
+	daemonSets []domain.DaemonSet
+	err        error
+}
+
+// Model for daemon sets.
+type Model struct {
+	app     *shared.App
+	list    list.Model
+	repo    daemonSetsRepo
+	focused focused
+	infobar *infobar.Model
+	loader  *shared.Loader
+}
+
+func New(app *shared.App, repo daemonSetsRepo) (*Model, error) {
+	m := Model{
+		repo:    repo,
+		app:     app,
+		infobar: infobar.New(),
+		loader:  shared.NewLoader(app.Styles.NamespaceSign),
+	}
+
+	itemsModel := list.New([]list.Item{}, &daemonSet{
+		Styles: app.Styles,
+	}, 0, 0)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
+	itemsModel.SetShowTitle(false)
+	itemsModel.SetShowStatusBar(false)
+	itemsModel.SetShowHelp(false)
+	itemsModel.Paginator.Type = paginator.Dots
+	m.list = itemsModel
+
+	m.setInfoBarHeight()
+
+	return &m, nil
+}
+
+func (m *Model) Init() tea.Cmd {
+	return m.load()
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case listMsg:
+		return m, m.applyList(msg)
+	case shared.ContextChangedMsg:
+		// the list is loaded again on the namespace change which follows the switch
+		shared.ClearList(&m.list, m.loader)
+		m.resetFocus()
+		m.setInfoContent()
+
+		return m, cmd
+	case shared.NamespaceChangedMsg:
+		m.resetFocus()
+
+		return m, m.load()
+	}
+
+	if cmd = m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.app.KeyMap.FocusRight):
+			m.changeFocusRight()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.FocusLeft):
+			m.changeFocusLeft()
+			m.infobar.ResetView()
+
+			return m, cmd
+		}
+	}
+
+	if m.listInFocus() {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+	} else {
+		_, cmd = m.infobar.Update(msg)
+	}
+
+	return m, cmd
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+func (m *Model) View() string {
+	m.setInfoBarHeight()
+
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(), m.list, m.loader))
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+
+	s.WriteString(
+		m.app.Styles.InitStyle.Render(
+			lipgloss.JoinHorizontal(
+				lipgloss.Top,
+				m.app.Styles.ListRightBorder.Render(m.list.View()),
+				m.renderInfoBar(),
+			),
+		))
+
+	return s.String()
+}
+
+// load loads daemon sets of the current namespace in background, the result is applied by `applyList`.
+func (m *Model) load() tea.Cmd {
+	namespace := m.app.CurrentNamespace
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	return tea.Batch(start, func() tea.Msg {
+		sets, err := m.repo.GetDaemonSets(context.Background(), namespace)
+
+		return listMsg{generation: generation, daemonSets: sets, err: err}
+	})
+}
+
+// applyList shows the loaded daemon sets. The previous list is kept if they can't be loaded.
+func (m *Model) applyList(msg listMsg) tea.Cmd {
+	// namespace or context has been changed since the list was requested
+	if !m.loader.Done(msg.generation) {
+		return nil
+	}
+
+	if msg.err != nil {
+		return shared.LoadError(shared.DaemonSetsTab, msg.err)
+	}
+
+	items := make([]list.Item, len(msg.daemonSets))
+	for i := range msg.daemonSets {
+		items[i] = newDaemonSet(msg.daemonSets[i])
+	}
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	m.setInfoContent()
+
+	return nil
+}
+
+func (m *Model) changeFocusRight() {
+	if m.listInFocus() {
+		m.focused = infoInFocus
+	}
+}
+
+func (m *Model) changeFocusLeft() {
+	if m.infoInFocus() {
+		m.focused = listInFocus
+	}
+}
+
+func (m *Model) listInFocus() bool {
+	return m.focused == listInFocus
+}
+
+func (m *Model) infoInFocus() bool {
+	return m.focused == infoInFocus
+}
+
+func (m *Model) resetFocus() {
+	m.focused = listInFocus
+	m.infobar.ResetIndent()
+	m.list.ResetSelected()
+}
+
+func (m *Model) renderInfoBar() string {
+	infoData := m.infobar.View()
+
+	if !m.infoInFocus() {
+		infoData = m.app.Styles.InactiveText.Render(infoData)
+	}
+
+	info := lipgloss.JoinVertical(lipgloss.Left,
+		m.renderInfoBarTabs(),
+		infoData,
+	)
+
+	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
+}
+
+func (m *Model) getCurrentDaemonSet() *daemonSet {
+	item := m.list.SelectedItem()
+	ds, ok := item.(*daemonSet)
+	if !ok {
+		return nil
+	}
+
+	return ds
+}
+
+func (m *Model) renderInfoBarTabs() string {
+	tabs := getInfoTabs()
+	titles := make([]string, len(tabs))
+	for i := range tabs {
+		if m.infoInFocus() {
+			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i])
+		} else {
+			titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i])
+		}
+	}
+
+	titlesStr := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		titles...,
+	)
+
+	gap := m.app.Styles.InfoGap.Render(
+		strings.Repeat(" ", shared.Max(0, m.app.GUI.ScreenWidth-lipgloss.Width(titlesStr))),
+	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, titlesStr, gap)
+}
+
+func (m *Model) setInfoContent() {
+	ds := m.getCurrentDaemonSet()
+	if ds == nil {
+		m.infobar.SetContent("")
+
+		return
+	}
+	ds.Styles = m.app.Styles
+	m.infobar.SetContent(ds.renderInfo())
+}
+
+func (m *Model) setInfoBarHeight() {
+	m.infobar.SetWH(
+		m.app.InfoBarWidth(getHeader()),
+		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+	)
+	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
+}
+
+func getInfoTabs() []string {
+	return []string{"Info"}
+}
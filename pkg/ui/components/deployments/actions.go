@@ -0,0 +1,84 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// actionMsg is a result of the action with a deployment.
+type actionMsg struct {
+	text string
+	err  error
+}
+
+func (m *Model) askRestart() {
+	dep := m.getCurrentDeployment()
+	if dep == nil {
+		return
+	}
+
+	namespace, name := dep.Namespace, dep.Name
+	m.confirm.Ask(fmt.Sprintf("Restart deployment %s?", name), func() tea.Msg {
+		if err := m.repo.RestartDeployment(context.Background(), namespace, name); err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("deployment %s restarted", name)}
+	})
+}
+
+func (m *Model) askScale() {
+	dep := m.getCurrentDeployment()
+	if dep == nil {
+		return
+	}
+
+	namespace, name := dep.Namespace, dep.Name
+	m.prompt.Ask(fmt.Sprintf("Scale deployment %s to replicas:", name), dep.Replicas, func(replicas int) tea.Cmd {
+		return m.scale(namespace, name, replicas)
+	})
+}
+
+func (m *Model) scale(namespace, name string, replicas int) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.repo.ScaleDeployment(context.Background(), namespace, name, replicas); err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("deployment %s scaled to %d", name, replicas)}
+	}
+}
+
+func (m *Model) askPause() {
+	dep := m.getCurrentDeployment()
+	if dep == nil {
+		return
+	}
+
+	action, done := "Pause", "paused"
+	if dep.Paused {
+		action, done = "Resume", "resumed"
+	}
+
+	namespace, name, paused := dep.Namespace, dep.Name, !dep.Paused
+	m.confirm.Ask(fmt.Sprintf("%s rollout of deployment %s?", action, name), func() tea.Msg {
+		if err := m.repo.PauseDeployment(context.Background(), namespace, name, paused); err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("deployment %s %s", name, done)}
+	})
+}
+
+func (m *Model) renderStatus() string {
+	switch {
+	case m.status == nil:
+		return ""
+	case m.status.err != nil:
+		return m.app.Styles.SelectedText.Render("Error: "+m.status.err.Error()) + "\n"
+	default:
+		return m.app.Styles.NamespaceSign.Render(m.status.text) + "\n"
+	}
+}
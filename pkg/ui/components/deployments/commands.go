@@ -0,0 +1,38 @@
+package deployments
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+var errNoDeploymentSelected = errors.New("no deployment selected")
+
+// RunCommand runs the command line action with the selected deployment.
+// `:scale` without the number asks for replicas like the scale key does.
+func (m *Model) RunCommand(cmd shared.Command) (tea.Cmd, error) {
+	if cmd.Name != shared.ScaleCommand {
+		return nil, fmt.Errorf("%w: %s", shared.ErrCommandNotAvailable, cmd.Name)
+	}
+
+	dep := m.getCurrentDeployment()
+	if dep == nil {
+		return nil, errNoDeploymentSelected
+	}
+
+	if len(cmd.Args) == 0 {
+		m.askScale()
+
+		return nil, nil
+	}
+
+	replicas, err := strconv.Atoi(cmd.Arg())
+	if err != nil || replicas < 0 {
+		return nil, fmt.Errorf("invalid number of replicas: %s", cmd.Arg())
+	}
+
+	return m.scale(dep.Namespace, dep.Name, replicas), nil
+}
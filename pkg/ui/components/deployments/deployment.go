@@ -15,12 +15,14 @@ import (
 )
 
 const (
+	namespaceHeader    = "Namespace"
 	nameHeader         = "Name"
 	readyHeader        = "Ready"
 	upToDateHeader     = "UpToDate"
 	availableHeader    = "Available"
 	ageHeader          = "Age"
 	minColumnGap       = "  "
+	namespaceColumnLen = 16
 	nameColumnLen      = 20
 	readyColumnLen     = 7
 	upToDateColumnLen  = len(upToDateHeader)
@@ -33,8 +35,13 @@ var boldText = lipgloss.NewStyle().Bold(true)
 
 type (
 	deployment struct {
-		Name              string
+		Name      string
+		Namespace string
+		// ShowNamespace adds the namespace column, it's shown when deployments of all namespaces are listed.
+		ShowNamespace     bool
 		Ready             string
+		Replicas          int
+		Paused            bool
 		UpdatedReplicas   int
 		AvailableReplicas int
 		ReadyReplicas     int
@@ -47,8 +54,27 @@ type (
 	}
 )
 
+func newDeployment(d domain.Deployment, showNamespace bool) *deployment {
+	return &deployment{
+		Name:              d.Name,
+		Namespace:         d.Namespace,
+		ShowNamespace:     showNamespace,
+		Created:           d.Created,
+		Ready:             d.Ready,
+		Replicas:          d.Replicas,
+		Paused:            d.Paused,
+		UpdatedReplicas:   d.UpdatedReplicas,
+		AvailableReplicas: d.AvailableReplicas,
+		ReadyReplicas:     d.ReadyReplicas,
+		Tolerations:       d.Tolerations,
+		Labels:            d.Labels,
+		Age:               d.Age,
+		Meta:              d.Meta,
+	}
+}
+
 // FilterValue is used to set filter item and required for `list.Model` interface.
-func (d *deployment) FilterValue() string { return d.Name }
+func (d *deployment) FilterValue() string { return shared.FilterValueWithLabels(d.Name, d.Labels) }
 func (d *deployment) Height() int         { return 1 }
 func (d *deployment) Spacing() int        { return 1 }
 func (d *deployment) Update(msg tea.Msg, m *list.Model) tea.Cmd {
@@ -64,6 +90,10 @@ func (d *deployment) Render(w io.Writer, m list.Model, index int, listItem list.
 	name := shared.GetTextWithLen(s.Name, nameColumnLen)
 
 	var row strings.Builder
+	if s.ShowNamespace {
+		row.WriteString(shared.GetTextWithLen(s.Namespace, namespaceColumnLen))
+		row.WriteString(minColumnGap)
+	}
 	row.WriteString(name)
 	row.WriteString(minColumnGap)
 
@@ -92,16 +122,24 @@ func (d *deployment) Render(w io.Writer, m list.Model, index int, listItem list.
 	}
 }
 
-func getHeader() string {
+func getHeader(showNamespace bool, sorter *shared.Sorter) string {
 	var header strings.Builder
 	header.WriteString(minColumnGap)
 
-	header.WriteString(nameHeader)
-	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	if showNamespace {
+		header.WriteString(namespaceHeader)
+		header.WriteString(strings.Repeat(" ", namespaceColumnLen-len(namespaceHeader)))
+		header.WriteString(minColumnGap)
+	}
+
+	nameTitle := sorter.Title(nameHeader)
+	header.WriteString(nameTitle)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-lipgloss.Width(nameTitle)))
 	header.WriteString(minColumnGap)
 
-	header.WriteString(readyHeader)
-	header.WriteString(strings.Repeat(" ", readyColumnLen-len(readyHeader)))
+	readyTitle := sorter.Title(readyHeader)
+	header.WriteString(readyTitle)
+	header.WriteString(strings.Repeat(" ", readyColumnLen-lipgloss.Width(readyTitle)))
 	header.WriteString(minColumnGap)
 
 	header.WriteString(upToDateHeader)
@@ -112,7 +150,7 @@ func getHeader() string {
 	header.WriteString(strings.Repeat(" ", availableColumnLen-len(availableHeader)))
 	header.WriteString(minColumnGap)
 
-	header.WriteString(ageHeader)
+	header.WriteString(sorter.Title(ageHeader))
 
 	return header.String()
 }
@@ -124,6 +162,11 @@ func (d *deployment) renderInfo() string {
 	info.WriteString(minColumnGap)
 	info.WriteString(d.Name)
 	info.WriteString("\n")
+	info.WriteString(boldText.Render("Namespace"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(d.Namespace)
+	info.WriteString("\n")
 	info.WriteString(boldText.Render("Created"))
 	info.WriteString("\n")
 	info.WriteString(minColumnGap)
@@ -143,19 +186,30 @@ func (d *deployment) renderInfo() string {
 	info.WriteString(boldText.Render("Replicas"))
 	info.WriteString("\n")
 	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Desired: %d\n", d.Replicas))
+	info.WriteString(minColumnGap)
 	info.WriteString(fmt.Sprintf("Available: %d\n", d.AvailableReplicas))
 	info.WriteString(minColumnGap)
 	info.WriteString(fmt.Sprintf("Ready: %d\n", d.ReadyReplicas))
 	info.WriteString(minColumnGap)
 	info.WriteString(fmt.Sprintf("Updated: %d\n", d.UpdatedReplicas))
 
+	info.WriteString(boldText.Render("Rollout"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	if d.Paused {
+		info.WriteString("Paused\n")
+	} else {
+		info.WriteString("Active\n")
+	}
+
 	info.WriteString(boldText.Render("Tolerations"))
 	info.WriteString("\n")
 	info.WriteString(minColumnGap)
 	info.WriteString(fmt.Sprintf("Total: %d\n", d.Tolerations))
 
 	info.WriteString(renderMeta(d.Meta))
-	info.WriteString(renderContainersInfo(d.Meta.Containers))
+	info.WriteString(shared.RenderContainersInfo(d.Meta.Containers))
 
 	return info.String()
 }
@@ -195,37 +249,3 @@ func renderMeta(meta domain.DeploymentMeta) string {
 
 	return info.String()
 }
-
-func renderContainersInfo(cc []domain.Container) string {
-	var info strings.Builder
-
-	info.WriteString(boldText.Render("Containers"))
-	info.WriteString("\n")
-	for i := range cc {
-		info.WriteString(minColumnGap)
-		info.WriteString(fmt.Sprintf("Name: %s", cc[i].Name))
-		info.WriteString("\n")
-		info.WriteString(minColumnGap)
-		info.WriteString(fmt.Sprintf("Image: %s", cc[i].Image))
-		info.WriteString("\n")
-		info.WriteString(minColumnGap)
-		info.WriteString(fmt.Sprintf("Policy: %s", cc[i].ImagePullPolicy))
-		info.WriteString("\n")
-		info.WriteString(minColumnGap)
-		info.WriteString(fmt.Sprintf("Termination message path: %s", cc[i].TerminationMessagePath))
-		info.WriteString("\n")
-		if len(cc[i].ENVs) > 0 {
-			info.WriteString(minColumnGap)
-			info.WriteString(boldText.Render("Envs"))
-			info.WriteString("\n")
-			for j := range cc[i].ENVs {
-				info.WriteString(minColumnGap)
-				info.WriteString(minColumnGap)
-				info.WriteString(cc[i].ENVs[j].Name)
-				info.WriteString("\n")
-			}
-		}
-	}
-
-	return info.String()
-}
@@ -0,0 +1,63 @@
+package deployments
+
+import (
+	"context"
+
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+const deploymentKind = "Deployment"
+
+// objectEventsMsg keeps events of the deployment.
+type objectEventsMsg struct {
+	namespace  string
+	deployment string
+	events     []domain.Event
+	err        error
+}
+
+// loadEvents requests events of the deployment in background.
+// The result is sent to the model events channel which is read by `waitForEvent` command.
+func (m *Model) loadEvents(namespace, name string) {
+	m.eventsNamespace, m.eventsDeployment = namespace, name
+	m.deploymentEvents = nil
+
+	go func() {
+		events, err := m.repo.GetObjectEvents(context.Background(), namespace, deploymentKind, name)
+		m.events <- objectEventsMsg{
+			namespace:  namespace,
+			deployment: name,
+			events:     events,
+			err:        err,
+		}
+	}()
+}
+
+func (m *Model) applyEvents(msg objectEventsMsg) {
+	// namespace or deployment has been changed since the request was sent
+	if msg.namespace != m.eventsNamespace || msg.deployment != m.eventsDeployment {
+		return
+	}
+
+	m.deploymentEvents = &msg
+	if m.focused == eventsInFocus {
+		m.setInfoContent()
+	}
+}
+
+// setEventsContent shows events of the deployment, they are requested when another deployment is selected.
+func (m *Model) setEventsContent(dep *deployment) {
+	if dep.Namespace != m.eventsNamespace || dep.Name != m.eventsDeployment {
+		m.loadEvents(dep.Namespace, dep.Name)
+	}
+
+	switch {
+	case m.deploymentEvents == nil:
+		m.infobar.SetContent("Loading...")
+	case m.deploymentEvents.err != nil:
+		m.infobar.SetContent("can't get events: " + m.deploymentEvents.err.Error())
+	default:
+		m.infobar.SetContent(shared.RenderEvents(m.deploymentEvents.events, m.app.Styles))
+	}
+}
@@ -0,0 +1,182 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+const (
+	revisionColumnLen = 8
+	// revisionsOffset is the number of history lines before the revisions list.
+	revisionsOffset = 1
+	currentRevision = "(current)"
+)
+
+// historyMsg keeps rollout revisions of the deployment.
+type historyMsg struct {
+	namespace  string
+	deployment string
+	revisions  []domain.Revision
+	// resourceVersion is the version of the deployment the revisions are read from,
+	// the rollback is rejected if the deployment is changed since then.
+	resourceVersion string
+	err             error
+}
+
+// loadHistory requests revisions of the deployment in background, the shown revisions are kept until the result comes.
+// The result is sent to the model events channel which is read by `waitForEvent` command.
+func (m *Model) loadHistory(namespace, name string) {
+	m.historyNamespace, m.historyDeployment = namespace, name
+
+	go func() {
+		revisions, resourceVersion, err := m.repo.GetDeploymentRevisions(context.Background(), namespace, name)
+		m.events <- historyMsg{
+			namespace:       namespace,
+			deployment:      name,
+			revisions:       revisions,
+			resourceVersion: resourceVersion,
+			err:             err,
+		}
+	}()
+}
+
+func (m *Model) applyHistory(msg historyMsg) {
+	// namespace or deployment has been changed since the request was sent
+	if msg.namespace != m.historyNamespace || msg.deployment != m.historyDeployment {
+		return
+	}
+
+	m.history = &msg
+	if m.revision >= len(msg.revisions) {
+		m.revision = 0
+	}
+	if m.focused == historyInFocus {
+		m.setInfoContent()
+	}
+}
+
+// refreshHistory requests revisions of the shown deployment again.
+// Revisions are changed by rollout, so it's called on deployment changes while the history is shown.
+func (m *Model) refreshHistory(namespace, name string) {
+	if m.focused == historyInFocus && namespace == m.historyNamespace && name == m.historyDeployment {
+		m.loadHistory(namespace, name)
+	}
+}
+
+// selectRevision moves the revisions cursor and scrolls the info bar to keep the selected revision visible.
+func (m *Model) selectRevision(i int) {
+	if m.history == nil || i < 0 || i >= len(m.history.revisions) {
+		return
+	}
+
+	m.revision = i
+	m.setInfoContent()
+	m.infobar.ShowLine(revisionsOffset + m.revision)
+}
+
+func (m *Model) askRollback() {
+	dep := m.getCurrentDeployment()
+	if dep == nil || m.history == nil || m.revision >= len(m.history.revisions) {
+		return
+	}
+
+	// the first revision is the current one, there is nothing to roll back
+	if m.revision == 0 {
+		return
+	}
+
+	namespace, name, number := dep.Namespace, dep.Name, m.history.revisions[m.revision].Number
+	resourceVersion := m.history.resourceVersion
+	m.confirm.Ask(fmt.Sprintf("Roll back deployment %s to revision %d?", name, number), func() tea.Msg {
+		if err := m.repo.RollbackDeployment(context.Background(), namespace, name, resourceVersion, number); err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("deployment %s rolled back to revision %d", name, number)}
+	})
+}
+
+// setHistoryContent shows revisions of the deployment, they are requested when another deployment is selected.
+func (m *Model) setHistoryContent(dep *deployment) {
+	if dep.Namespace != m.historyNamespace || dep.Name != m.historyDeployment {
+		m.history = nil
+		m.revision = 0
+		m.loadHistory(dep.Namespace, dep.Name)
+	}
+
+	switch {
+	case m.history == nil:
+		m.infobar.SetContent(m.renderStatus() + "Loading...")
+	case m.history.err != nil:
+		m.infobar.SetContent(m.renderStatus() + "can't get history: " + m.history.err.Error())
+	default:
+		m.infobar.SetContent(m.renderStatus() + m.renderHistory(m.history.revisions))
+	}
+}
+
+// renderHistory renders the list of revisions with the selected one and the pod template diff.
+// The selected revision is compared with the current one: the diff shows what the rollback changes.
+// The current revision is compared with the previous one: the diff shows what the last rollout changed.
+func (m *Model) renderHistory(revisions []domain.Revision) string {
+	var info strings.Builder
+
+	info.WriteString(boldText.Render("Revisions"))
+	info.WriteString("\n")
+
+	if len(revisions) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString("No revisions")
+
+		return info.String()
+	}
+
+	for i := range revisions {
+		line := renderRevision(&revisions[i])
+		if i == 0 {
+			line += minColumnGap + currentRevision
+		}
+		if i == m.revision {
+			info.WriteString(m.app.Styles.SelectedText.Render("> " + line))
+		} else {
+			info.WriteString(minColumnGap + line)
+		}
+		info.WriteString("\n")
+	}
+
+	from, to := &revisions[0], &revisions[m.revision]
+	if m.revision == 0 {
+		if len(revisions) == 1 {
+			return info.String()
+		}
+		from, to = &revisions[1], &revisions[0]
+	}
+
+	info.WriteString("\n")
+	info.WriteString(boldText.Render(fmt.Sprintf("Diff %d -> %d", from.Number, to.Number)))
+	info.WriteString("\n")
+	info.WriteString(shared.RenderDiff(from.Template, to.Template, m.app.Styles))
+	info.WriteString("\n")
+
+	return info.String()
+}
+
+func renderRevision(rev *domain.Revision) string {
+	var line strings.Builder
+	line.WriteString(shared.GetTextWithLen(strconv.FormatInt(rev.Number, 10), revisionColumnLen))
+	line.WriteString(minColumnGap)
+	line.WriteString(rev.Age)
+	line.WriteString(minColumnGap)
+	line.WriteString(strings.Join(rev.Images, ","))
+	if rev.ChangeCause != "" {
+		line.WriteString(minColumnGap)
+		line.WriteString(rev.ChangeCause)
+	}
+
+	return line.String()
+}
@@ -2,9 +2,7 @@ package deployments
 
 import (
 	"context"
-	"fmt"
 	"strings"
-	"sync"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
@@ -13,8 +11,10 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/tty2/kubic/pkg/domain"
 	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/confirm"
 	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
 	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/prompt"
 )
 
 type focused int
@@ -22,26 +22,56 @@ type focused int
 const (
 	listInFocus focused = iota
 	infoInFocus
+	historyInFocus
+	eventsInFocus
 )
 
 type deploymentsRepo interface {
-	GetDeployments(ctx context.Context, namespace string) ([]domain.Deployment, error)
+	WatchDeployments(ctx context.Context, namespace string) <-chan domain.DeploymentChange
+	GetObjectEvents(ctx context.Context, namespace, kind, name string) ([]domain.Event, error)
+	RestartDeployment(ctx context.Context, namespace, name string) error
+	ScaleDeployment(ctx context.Context, namespace, name string, replicas int) error
+	PauseDeployment(ctx context.Context, namespace, name string, paused bool) error
+	GetDeploymentRevisions(ctx context.Context, namespace, name string) ([]domain.Revision, string, error)
+	RollbackDeployment(ctx context.Context, namespace, name, resourceVersion string, revision int64) error
+	GetDeploymentPodOwners(ctx context.Context, namespace, name string) ([]domain.OwnerInfo, error)
+}
+
+// changeMsg is a deployment change received from the namespace watch.
+type changeMsg struct {
+	generation int
+	change     domain.DeploymentChange
 }
 
 // Model for deployments.
-// Mutex is necessary here.
-// We must synchronize UpdateList function call and View function call on update namespaces.
-// In order to make user interface faster on update namespace we call update callbacks in another goroutine.
-// namespaces/model.go package Model.setActive() function has go m.app.OnUpdateNamespace()
-// If user switch tab faster than k8s makes call to update list, user will get outdated list.
-// Mutex helps us to wait for k8s response and update list before view.
 type Model struct {
-	app     *shared.App
-	list    list.Model
-	repo    deploymentsRepo
-	mu      sync.Mutex
-	focused focused
-	infobar *infobar.Model
+	app         *shared.App
+	list        list.Model
+	repo        deploymentsRepo
+	focused     focused
+	infobar     *infobar.Model
+	loader      *shared.Loader
+	confirm     *confirm.Model
+	prompt      *prompt.Model
+	events      chan tea.Msg
+	cancelWatch context.CancelFunc
+	// deployments are all the deployments received from the watch by `namespace/name`.
+	// The list is built from them when the watch is synced, the previous list is shown until then.
+	deployments map[string]domain.Deployment
+	synced      bool
+	// eventsNamespace and eventsDeployment are the deployment which events are shown or being loaded.
+	eventsNamespace  string
+	eventsDeployment string
+	deploymentEvents *objectEventsMsg
+	// historyNamespace and historyDeployment are the deployment which revisions are shown or being loaded.
+	historyNamespace  string
+	historyDeployment string
+	history           *historyMsg
+	// revision is an index of the selected revision in the history.
+	revision int
+	// status is the result of the last action.
+	status *actionMsg
+	sorter *shared.Sorter
 }
 
 func New(app *shared.App, repo deploymentsRepo) (*Model, error) {
@@ -49,23 +79,22 @@ func New(app *shared.App, repo deploymentsRepo) (*Model, error) {
 		repo:    repo,
 		app:     app,
 		infobar: infobar.New(),
+		loader:  shared.NewLoader(app.Styles.NamespaceSign),
+		confirm: confirm.New(),
+		prompt:  prompt.New(),
+		events:  make(chan tea.Msg),
+		sorter:  shared.NewSorter(sortColumns()...),
 	}
 
 	itemsModel := list.New([]list.Item{}, &deployment{
 		Styles: app.Styles,
 	}, 0, 0)
-	itemsModel.SetFilteringEnabled(false)
-	itemsModel.SetShowFilter(false)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
 	itemsModel.SetShowTitle(false)
 	itemsModel.SetShowStatusBar(false)
 	itemsModel.SetShowHelp(false)
 	itemsModel.Paginator.Type = paginator.Dots
 	m.list = itemsModel
-	m.UpdateList()
-
-	m.app.AddUpdateNamespaceCallback(m.UpdateList)
-	m.app.AddUpdateNamespaceCallback(m.resetFocus)
-	m.app.AddUpdateNamespaceCallback(m.setInfoContent)
 
 	m.setInfoBarHeight()
 
@@ -73,14 +102,83 @@ func New(app *shared.App, repo deploymentsRepo) (*Model, error) {
 }
 
 func (m *Model) Init() tea.Cmd {
-	return nil
+	return tea.Batch(m.waitForEvent(), m.watch())
 }
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
+	switch msg := msg.(type) {
+	case shared.ContextChangedMsg:
+		// the watch is started again on the namespace change which follows the switch
+		m.stopWatch()
+		shared.ClearList(&m.list, m.loader)
+		m.resetFocus()
+		m.setInfoContent()
+
+		return m, cmd
+	case shared.NamespaceChangedMsg:
+		m.resetFocus()
+
+		return m, m.watch()
+	case changeMsg:
+		return m, tea.Batch(m.waitForEvent(), m.applyChange(msg))
+	case objectEventsMsg:
+		m.applyEvents(msg)
+
+		return m, m.waitForEvent()
+	case historyMsg:
+		m.applyHistory(msg)
+
+		return m, m.waitForEvent()
+	case actionMsg:
+		m.status = &msg
+		m.refreshHistory(m.historyNamespace, m.historyDeployment)
+		m.setInfoContent()
+
+		return m, cmd
+	case shared.BackMsg:
+		if msg.Owner.Tab == shared.DeploymentsTab {
+			m.selectDeployment(msg.Owner.Namespace, msg.Owner.Name)
+		}
+
+		return m, cmd
+	}
+
+	if cmd = m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	if m.confirm.Open() {
+		_, cmd = m.confirm.Update(msg)
+
+		return m, cmd
+	}
+
+	if m.prompt.Open() {
+		_, cmd = m.prompt.Update(msg)
+
+		return m, cmd
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
 	if msg, ok := msg.(tea.KeyMsg); ok {
 		switch {
+		case key.Matches(msg, m.app.KeyMap.SortColumn):
+			m.sort(m.sorter.NextColumn)
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.ReverseSort):
+			m.sort(m.sorter.Reverse)
+
+			return m, cmd
 		case key.Matches(msg, m.app.KeyMap.FocusRight):
 			m.changeFocusRight()
 
@@ -89,6 +187,37 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.changeFocusLeft()
 			m.infobar.ResetView()
 
+			return m, cmd
+		case m.listInFocus() && key.Matches(msg, m.app.KeyMap.ShowPods):
+			return m, m.showPods()
+		case key.Matches(msg, m.app.KeyMap.RestartDeployment):
+			m.askRestart()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.ScaleDeployment):
+			m.askScale()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.PauseDeployment):
+			m.askPause()
+
+			return m, cmd
+		case m.focused == historyInFocus && key.Matches(msg, m.app.KeyMap.Up):
+			m.selectRevision(m.revision - 1)
+
+			return m, cmd
+		case m.focused == historyInFocus && key.Matches(msg, m.app.KeyMap.Down):
+			m.selectRevision(m.revision + 1)
+
+			return m, cmd
+		case m.focused == historyInFocus && key.Matches(msg, m.app.KeyMap.RollbackDeployment):
+			m.askRollback()
+
+			return m, cmd
+		case m.focused == eventsInFocus && key.Matches(msg, m.app.KeyMap.RefreshEvents):
+			m.eventsDeployment = ""
+			m.setInfoContent()
+
 			return m, cmd
 		}
 	}
@@ -103,26 +232,26 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+// DialogOpen returns true if the confirmation dialog or the scale prompt waits for the answer.
+func (m *Model) DialogOpen() bool {
+	return m.confirm.Open() || m.prompt.Open()
+}
+
 func (m *Model) View() string {
 	m.setInfoBarHeight()
 
 	var s strings.Builder
 	s.WriteString("\n")
-	header := getHeader()
-	header = fmt.Sprintf("%s%s%s",
-		header,
-		strings.Repeat(" ", shared.Max(
-			len(minColumnGap),
-			m.app.GUI.ScreenWidth-lipgloss.Width(header)-lipgloss.Width(m.app.CurrentNamespace)-m.app.Styles.TextRightMargin)),
-		m.app.CurrentNamespace)
-	s.WriteString(m.app.Styles.InactiveText.Render(header))
+	s.WriteString(shared.RenderWorkloadsTableHeader(m.app, m.header(), m.list, m.loader))
 	s.WriteString("\n")
 	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
 	s.WriteString("\n")
 
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
 	s.WriteString(
 		m.app.Styles.InitStyle.Render(
 			lipgloss.JoinHorizontal(
@@ -135,42 +264,156 @@ func (m *Model) View() string {
 	return s.String()
 }
 
-func (m *Model) UpdateList() {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+// watch restarts watching deployments of the current namespace or of all namespaces.
+// Changes are forwarded to the model events channel which is read by `waitForEvent` command.
+// The watch lists deployments first, so the list is loaded by it too: the spinner is shown until it's synced.
+func (m *Model) watch() tea.Cmd {
+	m.stopWatch()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelWatch = cancel
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	namespace := m.app.WorkloadsNamespace()
+	changes := m.repo.WatchDeployments(ctx, namespace)
+
+	go func() {
+		for change := range changes {
+			m.events <- changeMsg{
+				generation: generation,
+				change:     change,
+			}
+		}
+	}()
 
-	deps, err := m.repo.GetDeployments(context.Background(), m.app.CurrentNamespace)
-	if err != nil {
-		return
+	return start
+}
+
+// stopWatch cancels the watch, changes it has sent already are dropped by the generation.
+func (m *Model) stopWatch() {
+	if m.cancelWatch != nil {
+		m.cancelWatch()
+		m.cancelWatch = nil
+	}
+	m.deployments = make(map[string]domain.Deployment)
+	m.synced = false
+}
+
+func (m *Model) waitForEvent() tea.Cmd {
+	return func() tea.Msg {
+		return <-m.events
+	}
+}
+
+// applyChange keeps the watched deployment and applies it to the list once the watch is synced.
+func (m *Model) applyChange(msg changeMsg) tea.Cmd {
+	// namespace or context has been changed since the event was sent
+	if msg.generation != m.loader.Generation() {
+		return nil
+	}
+
+	dep := msg.change.Deployment
+	key := dep.Namespace + "/" + dep.Name
+	switch msg.change.Type {
+	case domain.Synced:
+		m.synced = true
+		m.loader.Stop()
+		m.setItems()
+
+		return nil
+	case domain.Failed:
+		// the watch retries the list, the previous list is kept meanwhile
+		m.loader.Stop()
+
+		return shared.LoadError(shared.DeploymentsTab, msg.change.Err)
+	case domain.Added, domain.Modified:
+		m.deployments[key] = dep
+	case domain.Deleted:
+		delete(m.deployments, key)
+	}
+
+	if !m.synced {
+		return nil
+	}
+
+	index := m.indexOf(dep.Namespace, dep.Name)
+	switch msg.change.Type {
+	case domain.Added, domain.Modified:
+		item := newDeployment(dep, m.app.AllNamespaces)
+		if index < 0 {
+			shared.ApplyFilter(&m.list, m.list.InsertItem(len(m.list.Items()), item))
+		} else {
+			shared.ApplyFilter(&m.list, m.list.SetItem(index, item))
+		}
+	case domain.Deleted:
+		if index >= 0 {
+			shared.RemoveItem(&m.list, index)
+		}
+	}
+	shared.SortList(&m.list, m.sorter)
+
+	m.refreshHistory(dep.Namespace, dep.Name)
+	m.setInfoContent()
+
+	return nil
+}
+
+// setItems replaces the list with the watched deployments.
+func (m *Model) setItems() {
+	items := make([]list.Item, 0, len(m.deployments))
+	for _, d := range m.deployments {
+		items = append(items, newDeployment(d, m.app.AllNamespaces))
 	}
+	m.sorter.Sort(items)
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	m.setInfoContent()
+}
 
-	items := make([]list.Item, len(deps))
-	for i := range deps {
-		items[i] = &deployment{
-			Name:              deps[i].Name,
-			Created:           deps[i].Created,
-			Ready:             deps[i].Ready,
-			UpdatedReplicas:   deps[i].UpdatedReplicas,
-			AvailableReplicas: deps[i].AvailableReplicas,
-			ReadyReplicas:     deps[i].ReadyReplicas,
-			Tolerations:       deps[i].Tolerations,
-			Labels:            deps[i].Labels,
-			Age:               deps[i].Age,
-			Meta:              deps[i].Meta,
+// indexOf returns index of the deployment in the list. Deployments of all namespaces can have the same names.
+func (m *Model) indexOf(namespace, name string) int {
+	items := m.list.Items()
+	for i := range items {
+		if d, ok := items[i].(*deployment); ok && d.Namespace == namespace && d.Name == name {
+			return i
 		}
 	}
 
-	m.list.SetItems(items)
+	return -1
 }
 
 func (m *Model) changeFocusRight() {
-	if m.listInFocus() {
+	switch m.focused {
+	case listInFocus:
 		m.focused = infoInFocus
+	case infoInFocus:
+		m.focused = historyInFocus
+		m.historyDeployment = ""
+		m.infobar.ResetIndent()
+		m.infobar.ResetView()
+		m.setInfoContent()
+	case historyInFocus:
+		m.focused = eventsInFocus
+		m.eventsDeployment = ""
+		m.infobar.ResetIndent()
+		m.infobar.ResetView()
+		m.setInfoContent()
 	}
 }
 
 func (m *Model) changeFocusLeft() {
-	if m.infoInFocus() {
+	switch m.focused {
+	case eventsInFocus:
+		m.focused = historyInFocus
+		m.historyDeployment = ""
+		m.infobar.ResetIndent()
+		m.setInfoContent()
+	case historyInFocus:
+		m.focused = infoInFocus
+		m.infobar.ResetIndent()
+		m.setInfoContent()
+	case infoInFocus:
 		m.focused = listInFocus
 	}
 }
@@ -180,16 +423,32 @@ func (m *Model) listInFocus() bool {
 }
 
 func (m *Model) infoInFocus() bool {
-	return m.focused == infoInFocus
+	return m.focused != listInFocus
 }
 
 func (m *Model) resetFocus() {
 	m.focused = listInFocus
+	m.status = nil
+	m.confirm.Close()
+	m.prompt.Close()
 	m.infobar.ResetIndent()
 	m.list.ResetSelected()
 }
 
 func (m *Model) renderInfoBar() string {
+	width := m.app.InfoBarWidth(m.header())
+	height := m.app.GUI.Areas.MainContent.Height - tableHeaderHeight
+	switch {
+	case m.confirm.Open():
+		return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(
+			m.confirm.View(width, height, m.app.Styles.MainText),
+		)
+	case m.prompt.Open():
+		return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(
+			m.prompt.View(width, height, m.app.Styles.MainText),
+		)
+	}
+
 	infoData := m.infobar.View()
 
 	if !m.infoInFocus() {
@@ -204,6 +463,11 @@ func (m *Model) renderInfoBar() string {
 	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
 }
 
+// header returns the list header, the namespace column is added when deployments of all namespaces are listed.
+func (m *Model) header() string {
+	return getHeader(m.app.AllNamespaces, m.sorter)
+}
+
 func (m *Model) getCurrentDeployment() *deployment {
 	item := m.list.SelectedItem()
 	dep, ok := item.(*deployment)
@@ -218,11 +482,12 @@ func (m *Model) renderInfoBarTabs() string {
 	tabs := getInfoTabs()
 	titles := make([]string, len(tabs))
 	for i := range tabs {
-		if m.infoInFocus() {
-			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i])
-		} else {
-			titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i])
+		if m.focused == tabs[i] {
+			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i].String())
+
+			continue
 		}
+		titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i].String())
 	}
 
 	titlesStr := lipgloss.JoinHorizontal(
@@ -240,24 +505,53 @@ func (m *Model) renderInfoBarTabs() string {
 func (m *Model) setInfoContent() {
 	dep := m.getCurrentDeployment()
 	if dep == nil {
-		m.infobar.SetContent("")
+		m.infobar.SetContent(m.renderStatus())
 
 		return
 	}
 	dep.Styles = m.app.Styles
+
+	switch m.focused {
+	case historyInFocus:
+		m.setHistoryContent(dep)
+
+		return
+	case eventsInFocus:
+		m.setEventsContent(dep)
+
+		return
+	}
+
 	m.infobar.SetContent(
-		m.getCurrentDeployment().renderInfo(),
+		m.renderStatus() + m.getCurrentDeployment().renderInfo(),
 	)
 }
 
 func (m *Model) setInfoBarHeight() {
 	m.infobar.SetWH(
-		m.app.GUI.ScreenWidth-lipgloss.Width(getHeader()),
+		m.app.InfoBarWidth(m.header()),
 		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
 	)
 	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
 }
 
-func getInfoTabs() []string {
-	return []string{"Info"}
+func getInfoTabs() []focused {
+	return []focused{
+		infoInFocus,
+		historyInFocus,
+		eventsInFocus,
+	}
+}
+
+func (f focused) String() string {
+	switch f {
+	case infoInFocus:
+		return "Info"
+	case historyInFocus:
+		return "History"
+	case eventsInFocus:
+		return "Events"
+	default:
+		return ""
+	}
 }
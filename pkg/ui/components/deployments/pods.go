@@ -0,0 +1,48 @@
+package deployments
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+// showPods resolves the replica sets of the selected deployment and drills down to their pods.
+func (m *Model) showPods() tea.Cmd {
+	dep := m.getCurrentDeployment()
+	if dep == nil {
+		return nil
+	}
+
+	namespace, name := dep.Namespace, dep.Name
+
+	return func() tea.Msg {
+		owners, err := m.repo.GetDeploymentPodOwners(context.Background(), namespace, name)
+		if err != nil {
+			return actionMsg{err: err}
+		}
+
+		return shared.ShowPodsMsg{
+			Owner: shared.PodsOwner{
+				Tab:       shared.DeploymentsTab,
+				Namespace: namespace,
+				Name:      name,
+				PodOwners: owners,
+			},
+		}
+	}
+}
+
+// selectDeployment selects the deployment which pods were shown. The list could be changed by the watch meanwhile,
+// so the deployment is looked up by name.
+func (m *Model) selectDeployment(namespace, name string) {
+	items := m.list.VisibleItems()
+	for i := range items {
+		if d, ok := items[i].(*deployment); ok && d.Namespace == namespace && d.Name == name {
+			m.list.Select(i)
+			m.setInfoContent()
+
+			return
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package deployments
+
+import (
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+// sortColumns returns the columns deployments can be sorted by.
+// Age is sorted by the creation time, the formatted age loses the precision. The youngest deployments go first.
+func sortColumns() []shared.SortColumn {
+	return []shared.SortColumn{
+		{Header: nameHeader, Less: byDeployment(func(a, b *deployment) bool { return a.Name < b.Name })},
+		{Header: readyHeader, Less: byDeployment(func(a, b *deployment) bool { return a.ReadyReplicas < b.ReadyReplicas })},
+		{Header: ageHeader, Less: byDeployment(func(a, b *deployment) bool { return a.Created.After(b.Created) })},
+	}
+}
+
+func byDeployment(less func(a, b *deployment) bool) func(a, b list.Item) bool {
+	return func(a, b list.Item) bool {
+		depA, okA := a.(*deployment)
+		depB, okB := b.(*deployment)
+		if !okA || !okB {
+			return false
+		}
+
+		return less(depA, depB)
+	}
+}
+
+// sort changes the sort order with the function and sorts the list, the selected deployment is kept.
+func (m *Model) sort(change func()) {
+	change()
+	shared.SortList(&m.list, m.sorter)
+	m.setInfoContent()
+}
@@ -0,0 +1,182 @@
+package events
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	typeHeader        = "Type"
+	reasonHeader      = "Reason"
+	objectHeader      = "Object"
+	countHeader       = "Count"
+	lastSeenHeader    = "Last seen"
+	messageHeader     = "Message"
+	minColumnGap      = "  "
+	typeColumnLen     = 7
+	reasonColumnLen   = 20
+	objectColumnLen   = 30
+	countColumnLen    = len(countHeader)
+	lastSeenColumnLen = len(lastSeenHeader)
+	messageColumnLen  = 30
+	tableHeaderHeight = 3
+)
+
+// nolint gochecknoglobals: used here on purpose
+var boldText = lipgloss.NewStyle().Bold(true)
+
+type (
+	event struct {
+		Name          string
+		Type          string
+		Reason        string
+		Message       string
+		Object        string
+		Count         int
+		LastSeen      string
+		LastTimestamp time.Time
+		Source        string
+		Styles        *themes.Styles
+	}
+)
+
+func newEvent(e domain.Event) *event {
+	return &event{
+		Name:          e.Name,
+		Type:          e.Type,
+		Reason:        e.Reason,
+		Message:       e.Message,
+		Object:        e.Object,
+		Count:         e.Count,
+		LastSeen:      e.LastSeen,
+		LastTimestamp: e.LastTimestamp,
+		Source:        e.Source,
+	}
+}
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+// Events are filtered by the involved object and the reason.
+func (e *event) FilterValue() string { return e.Object + ":" + e.Reason }
+func (e *event) Height() int         { return 1 }
+func (e *event) Spacing() int        { return 1 }
+func (e *event) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (e *event) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	ev, ok := listItem.(*event)
+	if !ok {
+		return
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(ev.Type, typeColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(ev.Reason, reasonColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(ev.Object, objectColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(ev.Count), countColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(ev.LastSeen, lastSeenColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(firstLine(ev.Message), messageColumnLen))
+
+	rowString := row.String()
+
+	switch {
+	case index == m.Index():
+		fmt.Fprint(w, e.Styles.SelectedText.Render(rowString))
+	case ev.Type == domain.EventTypeWarning:
+		fmt.Fprint(w, e.Styles.WarningText.Render(rowString))
+	default:
+		fmt.Fprint(w, e.Styles.MainText.Render(rowString))
+	}
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(typeHeader)
+	header.WriteString(strings.Repeat(" ", typeColumnLen-len(typeHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(reasonHeader)
+	header.WriteString(strings.Repeat(" ", reasonColumnLen-len(reasonHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(objectHeader)
+	header.WriteString(strings.Repeat(" ", objectColumnLen-len(objectHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(countHeader)
+	header.WriteString(strings.Repeat(" ", countColumnLen-len(countHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(lastSeenHeader)
+	header.WriteString(strings.Repeat(" ", lastSeenColumnLen-len(lastSeenHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(messageHeader)
+	header.WriteString(strings.Repeat(" ", messageColumnLen-len(messageHeader)))
+
+	return header.String()
+}
+
+func (e *event) renderInfo() string {
+	var info strings.Builder
+
+	info.WriteString(boldText.Render("Object: "))
+	info.WriteString(e.Object)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Type: "))
+	if e.Type == domain.EventTypeWarning {
+		info.WriteString(e.Styles.WarningText.Render(e.Type))
+	} else {
+		info.WriteString(e.Type)
+	}
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Reason: "))
+	info.WriteString(e.Reason)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Count: "))
+	info.WriteString(strconv.Itoa(e.Count))
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Last seen: "))
+	info.WriteString(e.LastTimestamp.Format(shared.TimeFormat))
+	info.WriteString("\n")
+	if e.Source != "" {
+		info.WriteString(boldText.Render("Source: "))
+		info.WriteString(e.Source)
+		info.WriteString("\n")
+	}
+	info.WriteString(boldText.Render("Message"))
+	info.WriteString("\n")
+	for _, line := range strings.Split(e.Message, "\n") {
+		info.WriteString(minColumnGap)
+		info.WriteString(line)
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
+
+func firstLine(s string) string {
+	return strings.SplitN(s, "\n", 2)[0]
+}
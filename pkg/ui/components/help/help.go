@@ -70,14 +70,33 @@ func (m *Model) View() string {
 
 func (m *Model) getHelp() string {
 	if m.help.ShowAll {
-		if m.app.CurrentTab == shared.NamespacesTab {
+		switch m.app.CurrentTab {
+		case shared.ContextsTab:
 			return m.help.FullHelpView(m.app.KeyMap.FullHelp())
+		case shared.NamespacesTab:
+			return m.help.FullHelpView(m.app.KeyMap.FullNamespacesHelp())
+		case shared.DeploymentsTab:
+			return m.help.FullHelpView(m.app.KeyMap.FullDeploymentsHelp())
+		case shared.PodsTab:
+			return m.help.FullHelpView(m.app.KeyMap.FullPodsHelp())
+		case shared.ServicesTab:
+			return m.help.FullHelpView(m.app.KeyMap.FullServicesHelp())
+		case shared.SecretsTab:
+			return m.help.FullHelpView(m.app.KeyMap.FullSecretsHelp())
+		case shared.CronJobsTab:
+			return m.help.FullHelpView(m.app.KeyMap.FullCronJobsHelp())
+		case shared.NodesTab:
+			return m.help.FullHelpView(m.app.KeyMap.FullNodesHelp())
+		case shared.EventsTab:
+			return m.help.FullHelpView(m.app.KeyMap.FullEventsHelp())
+		case shared.PortForwardsTab:
+			return m.help.FullHelpView(m.app.KeyMap.FullPortForwardsHelp())
+		default:
+			return m.help.FullHelpView(m.app.KeyMap.FullWithFocus())
 		}
-
-		return m.help.FullHelpView(m.app.KeyMap.FullWithFocus())
 	}
 
-	if m.app.CurrentTab == shared.NamespacesTab {
+	if m.app.CurrentTab == shared.ContextsTab || m.app.CurrentTab == shared.NamespacesTab {
 		return m.help.ShortHelpView(m.app.KeyMap.ShortHelp())
 	}
 
@@ -0,0 +1,42 @@
+package namespaces
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+// CommandArgs returns the names to complete `:ns` command, all namespaces entry included.
+func (m *Model) CommandArgs() []string {
+	items := m.list.Items()
+	names := make([]string, 0, len(items))
+	for i := range items {
+		if n, ok := items[i].(*namespace); ok {
+			names = append(names, n.Name)
+		}
+	}
+
+	return names
+}
+
+// RunCommand activates the namespace typed in `:ns name` command like it's selected in the list.
+func (m *Model) RunCommand(cmd shared.Command) (tea.Cmd, error) {
+	if tab, ok := cmd.Tab(); !ok || tab != shared.NamespacesTab {
+		return nil, fmt.Errorf("%w: %s", shared.ErrCommandNotAvailable, cmd.Name)
+	}
+
+	items := m.list.Items()
+	for i := range items {
+		if n, ok := items[i].(*namespace); ok && n.Name == cmd.Arg() {
+			// the namespace may be hidden by the filter
+			m.list.ResetFilter()
+			m.list.Select(i)
+			m.setActive()
+
+			return shared.NamespaceChanged, nil
+		}
+	}
+
+	return nil, fmt.Errorf("namespace %s not found", cmd.Arg())
+}
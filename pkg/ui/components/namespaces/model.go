@@ -2,15 +2,12 @@ package namespaces
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/paginator"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 	"github.com/tty2/kubic/pkg/domain"
 	"github.com/tty2/kubic/pkg/ui/shared"
 	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
@@ -20,42 +17,84 @@ type namespacesRepo interface {
 	GetNamespaces(ctx context.Context) ([]domain.Namespace, error)
 }
 
+// namespacesMsg is a result of the namespaces loading.
+// contextChanged is set when namespaces of the new context are loaded, all the components must be reloaded then.
+type namespacesMsg struct {
+	generation     int
+	namespaces     []domain.Namespace
+	err            error
+	contextChanged bool
+}
+
+// Model for namespaces.
+// The chosen namespace is reported by `shared.NamespaceChangedMsg`, namespaced components load their lists then.
 type Model struct {
-	app  *shared.App
-	list list.Model
-	repo namespacesRepo
+	app    *shared.App
+	list   list.Model
+	repo   namespacesRepo
+	loader *shared.Loader
+	sorter *shared.Sorter
 }
 
 func New(app *shared.App, repo namespacesRepo) (*Model, error) {
 	m := Model{
-		repo: repo,
-		app:  app,
+		repo:   repo,
+		app:    app,
+		loader: shared.NewLoader(app.Styles.NamespaceSign),
+		sorter: shared.NewSorter(sortColumns()...),
 	}
 
 	itemsModel := list.New([]list.Item{}, &namespace{
 		Styles: app.Styles,
 	}, 0, 0)
-	itemsModel.SetFilteringEnabled(false)
-	itemsModel.SetShowFilter(false)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
 	itemsModel.SetShowTitle(false)
 	itemsModel.SetShowStatusBar(false)
 	itemsModel.SetShowHelp(false)
 	itemsModel.Paginator.Type = paginator.Dots
 	m.list = itemsModel
-	m.UpdateList()
-	m.setActive()
 
 	return &m, nil
 }
 
 func (m *Model) Init() tea.Cmd {
-	return nil
+	return m.load(false)
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
 }
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	if msg, ok := msg.(tea.KeyMsg); ok {
-		if key.Matches(msg, m.app.KeyMap.Select) {
+	switch msg := msg.(type) {
+	case namespacesMsg:
+		return m, m.applyList(msg)
+	case shared.ContextChangedMsg:
+		// nothing must be left from the previous cluster, even if namespaces can't be loaded
+		shared.ClearList(&m.list, m.loader)
+
+		return m, m.load(true)
+	}
+
+	if cmd := m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && m.list.FilterState() != list.Filtering {
+		switch {
+		case key.Matches(msg, m.app.KeyMap.Select):
 			m.setActive()
+
+			return m, shared.NamespaceChanged
+		case key.Matches(msg, m.app.KeyMap.SortColumn):
+			m.sort(m.sorter.NextColumn)
+
+			return m, nil
+		case key.Matches(msg, m.app.KeyMap.ReverseSort):
+			m.sort(m.sorter.Reverse)
+
+			return m, nil
 		}
 	}
 
@@ -68,36 +107,33 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m *Model) View() string {
 	var s strings.Builder
 	s.WriteString("\n")
-	header := getHeader()
-	header = fmt.Sprintf("%s%s%s",
-		header,
-		strings.Repeat(" ", shared.Max(
-			len(minColumnGap),
-			m.app.GUI.ScreenWidth-lipgloss.Width(header)-lipgloss.Width(m.app.CurrentNamespace)-m.app.Styles.TextRightMargin)),
-		m.app.CurrentNamespace)
-	s.WriteString(m.app.Styles.InactiveText.Render(header))
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(m.sorter), m.list, m.loader))
 	s.WriteString("\n")
 	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
 	s.WriteString("\n")
+
 	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
 	s.WriteString(m.app.Styles.InitStyle.Render(m.list.View()))
 
 	return s.String()
 }
 
+// setActive marks the selected namespace as active and makes it current.
 func (m *Model) setActive() {
-	selected := m.list.Index()
+	selected := m.list.SelectedItem()
 	items := m.list.Items()
 	for i := range items {
 		s, ok := items[i].(*namespace)
 		if !ok {
 			return
 		}
-		if i == selected {
+		if items[i] == selected {
 			s.Active = true
-			m.app.CurrentNamespace = s.Name
-
-			go m.app.OnUpdateNamespace()
+			// the other resources stay in the last chosen namespace in all namespaces mode
+			m.app.AllNamespaces = s.All
+			if !s.All {
+				m.app.CurrentNamespace = s.Name
+			}
 
 			continue
 		}
@@ -105,25 +141,97 @@ func (m *Model) setActive() {
 	}
 }
 
-func (m *Model) UpdateList() {
-	ns, err := m.repo.GetNamespaces(context.Background())
-	if err != nil {
-		log.Fatalf("can't get namespaces: %v", err)
+// load loads namespaces in background, the result is applied by `applyList`.
+func (m *Model) load(contextChanged bool) tea.Cmd {
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	load := func() tea.Msg {
+		ns, err := m.repo.GetNamespaces(context.Background())
+
+		return namespacesMsg{
+			generation:     generation,
+			namespaces:     ns,
+			err:            err,
+			contextChanged: contextChanged,
+		}
+	}
+
+	return tea.Batch(start, load)
+}
+
+// applyList shows the loaded namespaces and activates the current namespace if the cluster has it.
+// The namespace change is reported if another namespace is activated or the context is switched.
+// The previous list is kept if namespaces can't be loaded, except of the context switch:
+// the list is cleared on the switch, nothing must be left from the previous cluster.
+func (m *Model) applyList(msg namespacesMsg) tea.Cmd {
+	// another load has been started since the list was requested, like on the context switch
+	if !m.loader.Done(msg.generation) {
+		return nil
 	}
 
-	items := make([]list.Item, len(ns))
-	for i := range ns {
-		n := namespace{
-			Name:   ns[i].Name,
-			Status: ns[i].Status,
-			Age:    ns[i].Age,
+	if msg.err != nil {
+		errCmd := shared.LoadError(shared.NamespacesTab, msg.err)
+		if msg.contextChanged {
+			return tea.Batch(errCmd, shared.NamespaceChanged)
 		}
-		if i == 0 {
-			n.Active = true
+
+		return errCmd
+	}
+
+	namespace, all := m.app.CurrentNamespace, m.app.AllNamespaces
+	m.setItems(msg.namespaces)
+	m.selectCurrent()
+	m.setActive()
+
+	if msg.contextChanged || namespace != m.app.CurrentNamespace || all != m.app.AllNamespaces {
+		return shared.NamespaceChanged
+	}
+
+	return nil
+}
+
+// selectCurrent moves the cursor to the current namespace or to the all namespaces entry.
+// The first namespace is selected if there is no such.
+func (m *Model) selectCurrent() {
+	items := m.list.Items()
+	for i := range items {
+		n, ok := items[i].(*namespace)
+		if !ok {
+			continue
+		}
+		if (m.app.AllNamespaces && n.All) || (!m.app.AllNamespaces && !n.All && n.Name == m.app.CurrentNamespace) {
+			m.list.Select(i)
+
+			return
 		}
+	}
+
+	if len(items) > 1 {
+		m.list.Select(1)
 
-		items[i] = &n
+		return
+	}
+
+	m.list.ResetSelected()
+}
+
+func (m *Model) setItems(ns []domain.Namespace) {
+	// all namespaces entry goes first, pods and deployments of every namespace are listed with it
+	items := make([]list.Item, 0, len(ns)+1)
+	items = append(items, &namespace{
+		Name: shared.AllNamespacesTitle,
+		All:  true,
+	})
+	for i := range ns {
+		items = append(items, &namespace{
+			Name:    ns[i].Name,
+			Status:  ns[i].Status,
+			Age:     ns[i].Age,
+			Created: ns[i].Created,
+		})
 	}
+	m.sortItems(items)
 
-	m.list.SetItems(items)
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -26,10 +27,13 @@ const (
 
 type (
 	namespace struct {
-		Name   string
-		Status string
-		Age    string
-		Active bool
+		Name    string
+		Status  string
+		Age     string
+		Created time.Time
+		Active  bool
+		// All is set for the all namespaces entry.
+		All    bool
 		Styles *themes.Styles
 	}
 )
@@ -74,19 +78,21 @@ func (n *namespace) Render(w io.Writer, m list.Model, index int, listItem list.I
 	}
 }
 
-func getHeader() string {
+func getHeader(sorter *shared.Sorter) string {
 	var header strings.Builder
 	header.WriteString(minColumnGap)
 
-	header.WriteString(nameHeader)
-	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	nameTitle := sorter.Title(nameHeader)
+	header.WriteString(nameTitle)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-lipgloss.Width(nameTitle)))
 	header.WriteString(minColumnGap)
 
-	header.WriteString(statusHeader)
-	header.WriteString(strings.Repeat(" ", statusColumnLen-len(statusHeader)))
+	statusTitle := sorter.Title(statusHeader)
+	header.WriteString(statusTitle)
+	header.WriteString(strings.Repeat(" ", statusColumnLen-lipgloss.Width(statusTitle)))
 	header.WriteString(minColumnGap)
 
-	header.WriteString(ageHeader)
+	header.WriteString(sorter.Title(ageHeader))
 
 	return header.String()
 }
@@ -0,0 +1,53 @@
+package namespaces
+
+import (
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+// sortColumns returns the columns namespaces can be sorted by.
+// Age is sorted by the creation time, the formatted age loses the precision. The youngest namespaces go first.
+func sortColumns() []shared.SortColumn {
+	return []shared.SortColumn{
+		{Header: nameHeader, Less: byNamespace(func(a, b *namespace) bool { return a.Name < b.Name })},
+		{Header: statusHeader, Less: byNamespace(func(a, b *namespace) bool { return a.Status < b.Status })},
+		{Header: ageHeader, Less: byNamespace(func(a, b *namespace) bool { return a.Created.After(b.Created) })},
+	}
+}
+
+func byNamespace(less func(a, b *namespace) bool) func(a, b list.Item) bool {
+	return func(a, b list.Item) bool {
+		nsA, okA := a.(*namespace)
+		nsB, okB := b.(*namespace)
+		if !okA || !okB {
+			return false
+		}
+
+		return less(nsA, nsB)
+	}
+}
+
+// sort changes the sort order with the function and sorts the list, the selected namespace is kept.
+func (m *Model) sort(change func()) {
+	change()
+
+	selected := m.list.SelectedItem()
+	items := make([]list.Item, len(m.list.Items()))
+	copy(items, m.list.Items())
+	m.sortItems(items)
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	shared.SelectItem(&m.list, selected)
+}
+
+// sortItems sorts namespaces in place, the all namespaces entry stays on top.
+func (m *Model) sortItems(items []list.Item) {
+	if len(items) > 0 {
+		if n, ok := items[0].(*namespace); ok && n.All {
+			m.sorter.Sort(items[1:])
+
+			return
+		}
+	}
+
+	m.sorter.Sort(items)
+}
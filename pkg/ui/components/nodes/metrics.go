@@ -0,0 +1,69 @@
+package nodes
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+// metricsInterval is how often the usage of nodes is updated. Metrics server scrapes kubelets every 15 seconds by default.
+const metricsInterval = 15 * time.Second
+
+// metricsTickMsg triggers the metrics update.
+type metricsTickMsg struct{}
+
+// metricsMsg keeps the usage of nodes.
+type metricsMsg struct {
+	generation int
+	metrics    []domain.NodeMetrics
+	err        error
+}
+
+func metricsTick() tea.Cmd {
+	return tea.Tick(metricsInterval, func(time.Time) tea.Msg {
+		return metricsTickMsg{}
+	})
+}
+
+// loadMetrics requests the usage of nodes in background.
+func (m *Model) loadMetrics() tea.Cmd {
+	generation := m.loader.Generation()
+
+	return func() tea.Msg {
+		metrics, err := m.repo.GetNodeMetrics(context.Background())
+
+		return metricsMsg{
+			generation: generation,
+			metrics:    metrics,
+			err:        err,
+		}
+	}
+}
+
+func (m *Model) applyMetrics(msg metricsMsg) {
+	// nodes have been loaded again since the request was sent, the usage could be of another cluster
+	if msg.generation != m.loader.Generation() {
+		return
+	}
+
+	setUsage(m.list.Items(), msg.metrics, msg.err)
+	m.setInfoContent()
+}
+
+// setUsage sets the usage to the listed nodes by their names.
+func setUsage(items []list.Item, metrics []domain.NodeMetrics, err error) {
+	usage := make(map[string]*domain.NodeMetrics, len(metrics))
+	for i := range metrics {
+		usage[metrics[i].Name] = &metrics[i]
+	}
+
+	for i := range items {
+		if n, ok := items[i].(*node); ok {
+			n.Metrics = usage[n.Name]
+			n.MetricsErr = err
+		}
+	}
+}
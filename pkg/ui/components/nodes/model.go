@@ -0,0 +1,570 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/confirm"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+)
+
+type focused int
+
+const (
+	listInFocus focused = iota
+	infoInFocus
+)
+
+type nodesRepo interface {
+	GetNodes(ctx context.Context) ([]domain.Node, error)
+	GetNodePods(ctx context.Context, name string) ([]domain.Pod, error)
+	CordonNode(ctx context.Context, name string, unschedulable bool) error
+	DrainNode(ctx context.Context, name string) (<-chan domain.DrainEvent, error)
+	GetNodeMetrics(ctx context.Context) ([]domain.NodeMetrics, error)
+}
+
+// podsMsg keeps pods scheduled on the node.
+type podsMsg struct {
+	node string
+	pods []domain.Pod
+	err  error
+}
+
+// actionMsg is a result of the action with a node.
+type actionMsg struct {
+	text string
+	err  error
+}
+
+// drainMsg is a progress event of the node drain. Done is set when all the pods are processed.
+// Session is used to find the end of the running drain.
+type drainMsg struct {
+	session int
+	node    string
+	event   domain.DrainEvent
+	done    bool
+}
+
+// startDrainMsg starts the drain of the node confirmed by user.
+type startDrainMsg struct {
+	node string
+}
+
+// listMsg is a result of the nodes loading.
+type listMsg struct {
+	generation int
+	nodes      []domain.Node
+	metrics    []domain.NodeMetrics
+	metricsErr error
+	err        error
+}
+
+// Model for nodes.
+// Nodes don't belong to namespaces, so the list is not updated on namespace change.
+type Model struct {
+	app     *shared.App
+	list    list.Model
+	repo    nodesRepo
+	focused focused
+	infobar *infobar.Model
+	loader  *shared.Loader
+	confirm *confirm.Model
+	events  chan tea.Msg
+	// podsOwner is the name of the node which pods are shown or being resolved.
+	podsOwner string
+	pods      *podsMsg
+	status    *actionMsg
+	// drainNode is the name of the last drained node and drainLog is its drain progress.
+	drainNode string
+	drainLog  []string
+	// cancelDrain stops the running drain, evictions are retried for minutes while disruption budgets refuse them.
+	cancelDrain  context.CancelFunc
+	drainSession int
+}
+
+func New(app *shared.App, repo nodesRepo) (*Model, error) {
+	m := Model{
+		repo:    repo,
+		app:     app,
+		infobar: infobar.New(),
+		loader:  shared.NewLoader(app.Styles.NamespaceSign),
+		confirm: confirm.New(),
+		events:  make(chan tea.Msg),
+	}
+
+	itemsModel := list.New([]list.Item{}, &node{
+		Styles: app.Styles,
+	}, 0, 0)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
+	itemsModel.SetShowTitle(false)
+	itemsModel.SetShowStatusBar(false)
+	itemsModel.SetShowHelp(false)
+	itemsModel.Paginator.Type = paginator.Dots
+	m.list = itemsModel
+
+	m.setInfoBarHeight()
+
+	return &m, nil
+}
+
+func (m *Model) Init() tea.Cmd {
+	return tea.Batch(m.waitForEvent(), m.load(), metricsTick())
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case listMsg:
+		return m, m.applyList(msg)
+	case shared.ContextChangedMsg:
+		// nodes don't belong to namespaces, but to the cluster of the context
+		m.stopDrain()
+		shared.ClearList(&m.list, m.loader)
+		m.resetFocus()
+		m.setInfoContent()
+
+		return m, m.load()
+	case metricsTickMsg:
+		return m, tea.Batch(m.loadMetrics(), metricsTick())
+	case metricsMsg:
+		m.applyMetrics(msg)
+
+		return m, cmd
+	case podsMsg:
+		if msg.node == m.podsOwner {
+			m.pods = &msg
+			m.setInfoContent()
+		}
+
+		return m, m.waitForEvent()
+	case startDrainMsg:
+		m.startDrain(msg.node)
+
+		return m, cmd
+	case drainMsg:
+		return m, tea.Batch(m.waitForEvent(), m.applyDrainEvent(msg))
+	case actionMsg:
+		m.status = &msg
+		m.setInfoContent()
+
+		return m, m.load()
+	}
+
+	if cmd = m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	if m.confirm.Open() {
+		_, cmd = m.confirm.Update(msg)
+
+		return m, cmd
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.app.KeyMap.FocusRight):
+			m.changeFocusRight()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.FocusLeft):
+			m.changeFocusLeft()
+			m.infobar.ResetView()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.CordonNode):
+			m.askCordon()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.DrainNode):
+			m.askDrain()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.StopDrain):
+			m.stopDrain()
+
+			return m, cmd
+		}
+	}
+
+	if m.listInFocus() {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+	} else {
+		_, cmd = m.infobar.Update(msg)
+	}
+
+	return m, cmd
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+// DialogOpen returns true if the confirmation dialog waits for the answer.
+func (m *Model) DialogOpen() bool {
+	return m.confirm.Open()
+}
+
+func (m *Model) View() string {
+	m.setInfoBarHeight()
+
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(), m.list, m.loader))
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+
+	s.WriteString(
+		m.app.Styles.InitStyle.Render(
+			lipgloss.JoinHorizontal(
+				lipgloss.Top,
+				m.app.Styles.ListRightBorder.Render(m.list.View()),
+				m.renderInfoBar(),
+			),
+		))
+
+	return s.String()
+}
+
+// load loads nodes with their usage in background, the result is applied by `applyList`.
+func (m *Model) load() tea.Cmd {
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	return tea.Batch(start, func() tea.Msg {
+		nodes, err := m.repo.GetNodes(context.Background())
+		if err != nil {
+			return listMsg{generation: generation, err: err}
+		}
+
+		// the usage is shown in the info bar only, the list is kept even if it's unavailable
+		metrics, metricsErr := m.repo.GetNodeMetrics(context.Background())
+
+		return listMsg{generation: generation, nodes: nodes, metrics: metrics, metricsErr: metricsErr}
+	})
+}
+
+// applyList shows the loaded nodes. The previous list is kept if they can't be loaded.
+func (m *Model) applyList(msg listMsg) tea.Cmd {
+	// another load has been started since the list was requested, like on the context switch
+	if !m.loader.Done(msg.generation) {
+		return nil
+	}
+
+	if msg.err != nil {
+		return shared.LoadError(shared.NodesTab, msg.err)
+	}
+
+	// pods must be resolved again for the new list
+	m.podsOwner = ""
+
+	items := make([]list.Item, len(msg.nodes))
+	for i := range msg.nodes {
+		items[i] = newNode(msg.nodes[i])
+	}
+	setUsage(items, msg.metrics, msg.metricsErr)
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	m.setInfoContent()
+
+	return nil
+}
+
+func (m *Model) waitForEvent() tea.Cmd {
+	return func() tea.Msg {
+		return <-m.events
+	}
+}
+
+// resolvePods requests pods of the node in background.
+// The result is sent to the model events channel which is read by `waitForEvent` command.
+func (m *Model) resolvePods(name string) {
+	go func() {
+		pods, err := m.repo.GetNodePods(context.Background(), name)
+		m.events <- podsMsg{
+			node: name,
+			pods: pods,
+			err:  err,
+		}
+	}()
+}
+
+func (m *Model) askCordon() {
+	n := m.getCurrentNode()
+	if n == nil {
+		return
+	}
+
+	action := "Cordon"
+	if n.Unschedulable {
+		action = "Uncordon"
+	}
+
+	name, unschedulable := n.Name, !n.Unschedulable
+	m.confirm.Ask(fmt.Sprintf("%s node %s?", action, name), func() tea.Msg {
+		if err := m.repo.CordonNode(context.Background(), name, unschedulable); err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("node %s: unschedulable is %t", name, unschedulable)}
+	})
+}
+
+func (m *Model) askDrain() {
+	n := m.getCurrentNode()
+	if n == nil {
+		return
+	}
+
+	name := n.Name
+	m.confirm.Ask(fmt.Sprintf("Drain node %s? All its pods except daemon sets will be evicted.", name), func() tea.Msg {
+		return startDrainMsg{node: name}
+	})
+}
+
+// startDrain drains the node in background, the running drain is stopped first.
+// The progress is sent to the model events channel which is read by `waitForEvent` command.
+func (m *Model) startDrain(name string) {
+	m.stopDrain()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelDrain = cancel
+	m.drainSession++
+	session := m.drainSession
+
+	go func() {
+		events, err := m.repo.DrainNode(ctx, name)
+		if err != nil {
+			m.events <- drainMsg{session: session, node: name, event: domain.DrainEvent{Err: err}}
+		} else {
+			for e := range events {
+				m.events <- drainMsg{session: session, node: name, event: e}
+			}
+		}
+		m.events <- drainMsg{session: session, node: name, done: true}
+	}()
+
+	m.status = &actionMsg{text: fmt.Sprintf("draining node %s", name)}
+	m.setInfoContent()
+}
+
+// stopDrain cancels the running drain. The pods evicted already are not returned, the node stays cordoned.
+func (m *Model) stopDrain() {
+	if m.cancelDrain == nil {
+		return
+	}
+
+	m.cancelDrain()
+	m.cancelDrain = nil
+	m.drainLog = append(m.drainLog, "drain is stopped")
+	m.setInfoContent()
+}
+
+// applyDrainEvent adds the event to the drain log. The nodes are reloaded when the drain is done.
+func (m *Model) applyDrainEvent(msg drainMsg) tea.Cmd {
+	if msg.node != m.drainNode {
+		m.drainNode = msg.node
+		m.drainLog = nil
+	}
+
+	var cmd tea.Cmd
+
+	switch {
+	case msg.done:
+		if msg.session == m.drainSession && m.cancelDrain != nil {
+			m.cancelDrain()
+			m.cancelDrain = nil
+		}
+		cmd = m.load()
+	case msg.event.Err != nil && msg.event.Pod != "":
+		m.drainLog = append(m.drainLog, fmt.Sprintf("%s: %s", msg.event.Pod, msg.event.Err))
+	case msg.event.Err != nil:
+		// the drain error is the last event, it's also shown as the action status
+		m.drainLog = append(m.drainLog, msg.event.Err.Error())
+		m.status = &actionMsg{err: msg.event.Err}
+	case msg.event.Pod != "":
+		m.drainLog = append(m.drainLog, fmt.Sprintf("%s: %s", msg.event.Pod, msg.event.Message))
+	default:
+		m.drainLog = append(m.drainLog, msg.event.Message)
+	}
+
+	m.setInfoContent()
+
+	return cmd
+}
+
+func (m *Model) changeFocusRight() {
+	if m.listInFocus() {
+		m.focused = infoInFocus
+	}
+}
+
+func (m *Model) changeFocusLeft() {
+	if m.infoInFocus() {
+		m.focused = listInFocus
+	}
+}
+
+func (m *Model) listInFocus() bool {
+	return m.focused == listInFocus
+}
+
+func (m *Model) infoInFocus() bool {
+	return m.focused == infoInFocus
+}
+
+func (m *Model) resetFocus() {
+	m.status = nil
+	m.drainNode = ""
+	m.drainLog = nil
+	m.confirm.Close()
+	m.focused = listInFocus
+	m.infobar.ResetIndent()
+	m.list.ResetSelected()
+}
+
+func (m *Model) renderInfoBar() string {
+	if m.confirm.Open() {
+		return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(
+			m.confirm.View(
+				m.app.InfoBarWidth(getHeader()),
+				m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+				m.app.Styles.MainText,
+			),
+		)
+	}
+
+	infoData := m.infobar.View()
+
+	if !m.infoInFocus() {
+		infoData = m.app.Styles.InactiveText.Render(infoData)
+	}
+
+	info := lipgloss.JoinVertical(lipgloss.Left,
+		m.renderInfoBarTabs(),
+		infoData,
+	)
+
+	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
+}
+
+func (m *Model) getCurrentNode() *node {
+	item := m.list.SelectedItem()
+	n, ok := item.(*node)
+	if !ok {
+		return nil
+	}
+
+	return n
+}
+
+func (m *Model) renderInfoBarTabs() string {
+	tabs := getInfoTabs()
+	titles := make([]string, len(tabs))
+	for i := range tabs {
+		if m.infoInFocus() {
+			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i])
+		} else {
+			titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i])
+		}
+	}
+
+	titlesStr := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		titles...,
+	)
+
+	gap := m.app.Styles.InfoGap.Render(
+		strings.Repeat(" ", shared.Max(0, m.app.GUI.ScreenWidth-lipgloss.Width(titlesStr))),
+	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, titlesStr, gap)
+}
+
+func (m *Model) setInfoContent() {
+	n := m.getCurrentNode()
+	if n == nil {
+		m.infobar.SetContent(m.renderStatus())
+
+		return
+	}
+	n.Styles = m.app.Styles
+
+	if n.Name != m.podsOwner {
+		m.podsOwner = n.Name
+		m.pods = nil
+		m.resolvePods(n.Name)
+	}
+
+	var info strings.Builder
+	info.WriteString(m.renderStatus())
+	if n.Name == m.drainNode && len(m.drainLog) > 0 {
+		info.WriteString(boldText.Render("Drain"))
+		info.WriteString("\n")
+		for _, line := range m.drainLog {
+			info.WriteString(minColumnGap)
+			info.WriteString(line)
+			info.WriteString("\n")
+		}
+	}
+	info.WriteString(n.renderInfo())
+	switch {
+	case m.pods == nil:
+		info.WriteString(boldText.Render("Pods"))
+		info.WriteString("\n")
+		info.WriteString(minColumnGap)
+		info.WriteString("Loading...")
+	case m.pods.err != nil:
+		info.WriteString(boldText.Render("Pods"))
+		info.WriteString("\n")
+		info.WriteString(minColumnGap)
+		info.WriteString(m.pods.err.Error())
+	default:
+		info.WriteString(renderPods(m.pods.pods))
+	}
+
+	m.infobar.SetContent(info.String())
+}
+
+func (m *Model) renderStatus() string {
+	switch {
+	case m.status == nil:
+		return ""
+	case m.status.err != nil:
+		return m.app.Styles.SelectedText.Render("Error: "+m.status.err.Error()) + "\n"
+	default:
+		return m.app.Styles.NamespaceSign.Render(m.status.text) + "\n"
+	}
+}
+
+func (m *Model) setInfoBarHeight() {
+	m.infobar.SetWH(
+		m.app.InfoBarWidth(getHeader()),
+		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+	)
+	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
+}
+
+func getInfoTabs() []string {
+	return []string{"Info"}
+}
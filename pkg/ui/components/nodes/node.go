@@ -0,0 +1,295 @@
+package nodes
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	nameHeader        = "Name"
+	statusHeader      = "Status"
+	rolesHeader       = "Roles"
+	versionHeader     = "Version"
+	podsHeader        = "Pods"
+	ageHeader         = "Age"
+	minColumnGap      = "  "
+	nameColumnLen     = 25
+	statusColumnLen   = 24 // `Ready,SchedulingDisabled`
+	rolesColumnLen    = 15
+	versionColumnLen  = 10
+	podsColumnLen     = len(podsHeader)
+	resourceColumnLen = 20
+	quantityColumnLen = 15
+	podNameColumnLen  = 40
+	tableHeaderHeight = 3
+	noneValue         = "<none>"
+)
+
+// nolint gochecknoglobals: used here on purpose
+var boldText = lipgloss.NewStyle().Bold(true)
+
+type (
+	node struct {
+		Name          string
+		Roles         []string
+		Status        string
+		Version       string
+		Unschedulable bool
+		PodCount      int
+		Age           string
+		Created       time.Time
+		Labels        map[string]string
+		InternalIP    string
+		Conditions    []domain.NodeCondition
+		Taints        []string
+		Capacity      map[string]string
+		Allocatable   map[string]string
+		// AllocatableResources are compared with the usage of the node.
+		AllocatableResources domain.Resources
+		// Metrics is the current usage of the node, MetricsErr is set if metrics can't be got.
+		Metrics    *domain.NodeMetrics
+		MetricsErr error
+		Styles     *themes.Styles
+	}
+)
+
+func newNode(n domain.Node) *node {
+	return &node{
+		Name:                 n.Name,
+		Roles:                n.Roles,
+		Status:               n.Status,
+		Version:              n.Version,
+		Unschedulable:        n.Unschedulable,
+		PodCount:             n.PodCount,
+		Age:                  n.Age,
+		Created:              n.Created,
+		Labels:               n.Labels,
+		InternalIP:           n.InternalIP,
+		Conditions:           n.Conditions,
+		Taints:               n.Taints,
+		Capacity:             n.Capacity,
+		Allocatable:          n.Allocatable,
+		AllocatableResources: n.AllocatableResources,
+	}
+}
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+func (n *node) FilterValue() string { return shared.FilterValueWithLabels(n.Name, n.Labels) }
+func (n *node) Height() int         { return 1 }
+func (n *node) Spacing() int        { return 1 }
+func (n *node) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (n *node) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	nd, ok := listItem.(*node)
+	if !ok {
+		return
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(nd.Name, nameColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(nd.Status, statusColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(nd.roles(), rolesColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(nd.Version, versionColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(nd.PodCount), podsColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(nd.Age)
+
+	rowString := row.String()
+
+	if index == m.Index() {
+		fmt.Fprint(w, n.Styles.SelectedText.Render(rowString))
+	} else {
+		fmt.Fprint(w, n.Styles.MainText.Render(rowString))
+	}
+}
+
+func (n *node) roles() string {
+	if len(n.Roles) == 0 {
+		return noneValue
+	}
+
+	return strings.Join(n.Roles, ",")
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(nameHeader)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(statusHeader)
+	header.WriteString(strings.Repeat(" ", statusColumnLen-len(statusHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(rolesHeader)
+	header.WriteString(strings.Repeat(" ", rolesColumnLen-len(rolesHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(versionHeader)
+	header.WriteString(strings.Repeat(" ", versionColumnLen-len(versionHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(podsHeader)
+	header.WriteString(minColumnGap)
+
+	header.WriteString(ageHeader)
+
+	return header.String()
+}
+
+func (n *node) renderInfo() string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Name"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(n.Name)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Created"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(n.Created.Format(shared.TimeFormat))
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Internal IP"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(n.InternalIP)
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("Conditions"))
+	info.WriteString("\n")
+	for _, c := range n.Conditions {
+		info.WriteString(minColumnGap)
+		info.WriteString(fmt.Sprintf("%s: %s", c.Type, c.Status))
+		if c.Reason != "" {
+			info.WriteString(fmt.Sprintf(" (%s)", c.Reason))
+		}
+		info.WriteString("\n")
+	}
+
+	info.WriteString(boldText.Render("Taints"))
+	info.WriteString("\n")
+	if len(n.Taints) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString(noneValue)
+		info.WriteString("\n")
+	}
+	for _, t := range n.Taints {
+		info.WriteString(minColumnGap)
+		info.WriteString(t)
+		info.WriteString("\n")
+	}
+
+	info.WriteString(n.renderResources())
+	info.WriteString(n.renderUsage())
+
+	return info.String()
+}
+
+// renderUsage renders the usage of the node against its allocatable resources like `kubectl top node` does.
+func (n *node) renderUsage() string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Usage"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+
+	switch {
+	case n.MetricsErr != nil:
+		info.WriteString(n.MetricsErr.Error())
+		info.WriteString("\n")
+	case n.Metrics == nil:
+		info.WriteString("no metrics yet")
+		info.WriteString("\n")
+	default:
+		info.WriteString("CPU: ")
+		info.WriteString(formatAllocatableUsage(n.Metrics.Usage.CPU, n.AllocatableResources.CPU, shared.FormatCPU))
+		info.WriteString("\n")
+		info.WriteString(minColumnGap)
+		info.WriteString("Memory: ")
+		info.WriteString(formatAllocatableUsage(n.Metrics.Usage.Memory, n.AllocatableResources.Memory, shared.FormatMemory))
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
+
+func formatAllocatableUsage(usage, allocatable int64, format func(int64) string) string {
+	if allocatable == 0 {
+		return format(usage)
+	}
+
+	return fmt.Sprintf("%s (%d%% of %s allocatable)", format(usage), usage*100/allocatable, format(allocatable))
+}
+
+// renderResources renders capacity and allocatable resources side by side.
+func (n *node) renderResources() string {
+	names := make([]string, 0, len(n.Capacity))
+	for k := range n.Capacity {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var info strings.Builder
+	info.WriteString(boldText.Render("Resources"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(shared.GetTextWithLen("", resourceColumnLen))
+	info.WriteString(minColumnGap)
+	info.WriteString(shared.GetTextWithLen("Capacity", quantityColumnLen))
+	info.WriteString(minColumnGap)
+	info.WriteString("Allocatable")
+	info.WriteString("\n")
+
+	for _, name := range names {
+		info.WriteString(minColumnGap)
+		info.WriteString(shared.GetTextWithLen(name, resourceColumnLen))
+		info.WriteString(minColumnGap)
+		info.WriteString(shared.GetTextWithLen(n.Capacity[name], quantityColumnLen))
+		info.WriteString(minColumnGap)
+		info.WriteString(n.Allocatable[name])
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
+
+func renderPods(pods []domain.Pod) string {
+	var info strings.Builder
+	info.WriteString(boldText.Render(fmt.Sprintf("Pods (%d)", len(pods))))
+	info.WriteString("\n")
+
+	for i := range pods {
+		info.WriteString(minColumnGap)
+		info.WriteString(shared.GetTextWithLen(pods[i].Namespace+"/"+pods[i].Name, podNameColumnLen))
+		info.WriteString(minColumnGap)
+		info.WriteString(pods[i].Ready)
+		info.WriteString(minColumnGap)
+		info.WriteString(pods[i].Status)
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
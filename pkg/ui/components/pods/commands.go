@@ -0,0 +1,26 @@
+package pods
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+var errNoPodSelected = errors.New("no pod selected")
+
+// RunCommand runs the command line action with the selected pod.
+// `:delete` opens the same dialog as the delete key, the pod is never deleted without confirmation.
+func (m *Model) RunCommand(cmd shared.Command) (tea.Cmd, error) {
+	if cmd.Name != shared.DeleteCommand {
+		return nil, fmt.Errorf("%w: %s", shared.ErrCommandNotAvailable, cmd.Name)
+	}
+
+	if m.getCurrentPod() == nil {
+		return nil, errNoPodSelected
+	}
+	m.askDelete()
+
+	return nil, nil
+}
@@ -0,0 +1,50 @@
+package pods
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+// actionMsg is a result of the action with a pod.
+type actionMsg struct {
+	text string
+	err  error
+}
+
+// askDelete opens the delete dialog for the selected pod.
+// The pod stays in the list with `Terminating` status until the watch reports it's deleted.
+func (m *Model) askDelete() {
+	p := m.getCurrentPod()
+	if p == nil {
+		return
+	}
+
+	namespace, name := p.Namespace, p.Name
+	m.confirm.AskDelete(fmt.Sprintf("Delete pod %s?", name), func(gracePeriod *int64, force bool) tea.Cmd {
+		return func() tea.Msg {
+			err := m.repo.DeletePod(context.Background(), namespace, name, domain.DeleteOptions{
+				GracePeriod: gracePeriod,
+				Force:       force,
+			})
+			if err != nil {
+				return actionMsg{err: err}
+			}
+
+			return actionMsg{text: fmt.Sprintf("pod %s deleted", name)}
+		}
+	})
+}
+
+func (m *Model) renderStatus() string {
+	switch {
+	case m.status == nil:
+		return ""
+	case m.status.err != nil:
+		return m.app.Styles.SelectedText.Render("Error: "+m.status.err.Error()) + "\n"
+	default:
+		return m.app.Styles.NamespaceSign.Render(m.status.text) + "\n"
+	}
+}
@@ -0,0 +1,132 @@
+package pods
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+const (
+	deploymentKind = "Deployment"
+	replicaSetKind = "ReplicaSet"
+)
+
+// replicaSetControllerMsg is the controller of the replica set of the pod got while pods of the deployment are shown.
+type replicaSetControllerMsg struct {
+	namespace  string
+	deployment string
+	replicaSet string
+	controller domain.OwnerInfo
+	err        error
+}
+
+// showOwnerPods keeps only the pods of the owner in the list until the back key is pressed.
+func (m *Model) showOwnerPods(owner shared.PodsOwner) tea.Cmd {
+	m.owner = &owner
+	m.resolvedReplicaSets = make(map[string]bool)
+
+	m.resetFocus()
+	m.list.ResetFilter()
+	m.setItems()
+
+	pods := make([]*domain.Pod, 0, len(m.pods))
+	for key := range m.pods {
+		p := m.pods[key]
+		pods = append(pods, &p)
+	}
+
+	return m.resolveReplicaSets(pods...)
+}
+
+// resolveReplicaSets requests controllers of the replica sets of the pods which are not known as the owner ones.
+// Replica sets of the deployment are got when the drill down is started, the deployment creates new ones
+// on rollout or restart, so their pods are listed once the replica set controller is resolved.
+func (m *Model) resolveReplicaSets(pods ...*domain.Pod) tea.Cmd {
+	if m.owner == nil || m.owner.Tab != shared.DeploymentsTab {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, p := range pods {
+		if p.Namespace != m.owner.Namespace || m.owner.Owns(p) {
+			continue
+		}
+
+		for _, o := range p.Meta.Owners {
+			// replica set is requested once even if it fails: pods are changed too often to retry on every change
+			if o.Kind != replicaSetKind || m.resolvedReplicaSets[o.Name] {
+				continue
+			}
+			m.resolvedReplicaSets[o.Name] = true
+			cmds = append(cmds, m.resolveReplicaSet(m.owner.Namespace, m.owner.Name, o.Name))
+		}
+	}
+
+	return tea.Batch(cmds...)
+}
+
+func (m *Model) resolveReplicaSet(namespace, deployment, replicaSet string) tea.Cmd {
+	return func() tea.Msg {
+		controller, err := m.repo.GetReplicaSetController(context.Background(), namespace, replicaSet)
+
+		return replicaSetControllerMsg{
+			namespace:  namespace,
+			deployment: deployment,
+			replicaSet: replicaSet,
+			controller: controller,
+			err:        err,
+		}
+	}
+}
+
+// applyReplicaSetController adds the replica set to the owners if it's controlled by the shown deployment.
+func (m *Model) applyReplicaSetController(msg replicaSetControllerMsg) {
+	// the drill down has been left or changed since the request was sent
+	if m.owner == nil || m.owner.Tab != shared.DeploymentsTab ||
+		m.owner.Namespace != msg.namespace || m.owner.Name != msg.deployment {
+		return
+	}
+
+	if msg.err != nil || msg.controller.Kind != deploymentKind || msg.controller.Name != msg.deployment {
+		return
+	}
+
+	m.owner.PodOwners = append(m.owner.PodOwners, domain.OwnerInfo{
+		Kind: replicaSetKind,
+		Name: msg.replicaSet,
+	})
+	m.setItems()
+}
+
+// back returns to the tab of the owner, all the pods are listed again.
+func (m *Model) back() tea.Cmd {
+	owner := m.owner
+	m.owner = nil
+
+	if owner == nil {
+		return nil
+	}
+
+	m.resetFocus()
+	m.setItems()
+
+	return func() tea.Msg {
+		return shared.BackMsg{Owner: *owner}
+	}
+}
+
+// clearOwner drops the drill down on the namespace change, the pods of the owner aren't listed anymore.
+func (m *Model) clearOwner() {
+	m.owner = nil
+}
+
+// tableHeader renders the table header with the breadcrumb while pods of the owner are shown.
+func (m *Model) tableHeader() string {
+	if m.owner != nil {
+		return shared.RenderDrillDownTableHeader(m.app, m.header(), m.list, m.loader, m.owner)
+	}
+
+	return shared.RenderWorkloadsTableHeader(m.app, m.header(), m.list, m.loader)
+}
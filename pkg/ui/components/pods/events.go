@@ -0,0 +1,63 @@
+package pods
+
+import (
+	"context"
+
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+const podKind = "Pod"
+
+// objectEventsMsg keeps events of the pod.
+type objectEventsMsg struct {
+	namespace string
+	pod       string
+	events    []domain.Event
+	err       error
+}
+
+// loadEvents requests events of the pod in background.
+// The result is sent to the model events channel which is read by `waitForEvent` command.
+func (m *Model) loadEvents(namespace, name string) {
+	m.eventsNamespace, m.eventsPod = namespace, name
+	m.podEvents = nil
+
+	go func() {
+		events, err := m.repo.GetObjectEvents(context.Background(), namespace, podKind, name)
+		m.events <- objectEventsMsg{
+			namespace: namespace,
+			pod:       name,
+			events:    events,
+			err:       err,
+		}
+	}()
+}
+
+func (m *Model) applyEvents(msg objectEventsMsg) {
+	// namespace or pod has been changed since the request was sent
+	if msg.namespace != m.eventsNamespace || msg.pod != m.eventsPod {
+		return
+	}
+
+	m.podEvents = &msg
+	if m.focused == eventsInFocus {
+		m.setInfoContent()
+	}
+}
+
+// setEventsContent shows events of the pod, they are requested when another pod is selected.
+func (m *Model) setEventsContent(p *pod) {
+	if p.Namespace != m.eventsNamespace || p.Name != m.eventsPod {
+		m.loadEvents(p.Namespace, p.Name)
+	}
+
+	switch {
+	case m.podEvents == nil:
+		m.infobar.SetContent("Loading...")
+	case m.podEvents.err != nil:
+		m.infobar.SetContent("can't get events: " + m.podEvents.err.Error())
+	default:
+		m.infobar.SetContent(shared.RenderEvents(m.podEvents.events, m.app.Styles))
+	}
+}
@@ -0,0 +1,115 @@
+package pods
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/muesli/cancelreader"
+	"github.com/tty2/kubic/pkg/domain"
+	"golang.org/x/term"
+)
+
+// shellCommand starts bash if the container has it and falls back to sh.
+// nolint gochecknoglobals: used here on purpose
+var shellCommand = []string{"/bin/sh", "-c", "command -v bash >/dev/null 2>&1 && exec bash || exec sh"}
+
+// execCommand runs an interactive shell in the container. It implements `tea.ExecCommand`,
+// so the program releases the terminal while the shell is running and restores it on exit.
+type execCommand struct {
+	repo      podsRepo
+	namespace string
+	pod       string
+	container string
+	stdin     io.Reader
+	stdout    io.Writer
+	stderr    io.Writer
+}
+
+func (c *execCommand) SetStdin(r io.Reader)  { c.stdin = r }
+func (c *execCommand) SetStdout(w io.Writer) { c.stdout = w }
+func (c *execCommand) SetStderr(w io.Writer) { c.stderr = w }
+
+// Run puts the local terminal into raw mode, so all the keys including ctrl+c are sent to the remote shell,
+// and propagates the terminal size changes until the shell exits.
+func (c *execCommand) Run() error {
+	stdin, ok := c.stdin.(*os.File)
+	if !ok || !term.IsTerminal(int(stdin.Fd())) {
+		return fmt.Errorf("exec requires a terminal")
+	}
+
+	state, err := term.MakeRaw(int(stdin.Fd()))
+	if err != nil {
+		return err
+	}
+	defer term.Restore(int(stdin.Fd()), state) // nolint errcheck: nothing to do with the error here
+
+	// remote command keeps reading stdin after the shell exits, the reader is canceled to not steal the next key
+	reader, err := cancelreader.NewReader(stdin)
+	if err != nil {
+		return err
+	}
+	// deferred calls run in reverse order: the reader is canceled first and closed then
+	defer reader.Close() // nolint errcheck: nothing to do with the error here
+	defer reader.Cancel()
+
+	resize, stop := watchTerminalSize(c.stdout)
+	defer stop()
+
+	return c.repo.Exec(c.namespace, c.pod, domain.ExecOptions{
+		Container: c.container,
+		Command:   shellCommand,
+		Stdin:     reader,
+		Stdout:    c.stdout,
+		Stderr:    c.stderr,
+		TTY:       true,
+		Resize:    resize,
+	})
+}
+
+// getTerminalSize returns the size of the terminal the writer belongs to.
+func getTerminalSize(w io.Writer) (domain.TerminalSize, bool) {
+	f, ok := w.(*os.File)
+	if !ok {
+		return domain.TerminalSize{}, false
+	}
+
+	width, height, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return domain.TerminalSize{}, false
+	}
+
+	return domain.TerminalSize{Width: uint16(width), Height: uint16(height)}, true
+}
+
+// execShell suspends the program and opens a shell in the selected container.
+// In logs the container which logs are shown is used, otherwise the first container of the pod.
+func (m *Model) execShell() tea.Cmd {
+	p := m.getCurrentPod()
+	if p == nil || len(p.Spec.Containers) == 0 {
+		return nil
+	}
+
+	container := p.Spec.Containers[0].Name
+	if m.focused == logInFocus {
+		if target := m.currentLogTarget(p); target.container != "" {
+			container = target.container
+		}
+	}
+
+	cmd := &execCommand{
+		repo:      m.repo,
+		namespace: p.Namespace,
+		pod:       p.Name,
+		container: container,
+	}
+
+	return tea.Exec(cmd, func(err error) tea.Msg {
+		if err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("shell in %s/%s exited", p.Name, container)}
+	})
+}
@@ -0,0 +1,263 @@
+package pods
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+const (
+	allContainersTitle    = "all containers"
+	previousTitle         = "previous"
+	initContainerPrefix   = "init:"
+	containerPickerHeight = 2
+	// maxLogLines is the number of the last log lines kept in the info bar while logs are followed.
+	maxLogLines = 5000
+)
+
+// The followed stream can't be opened while the container is waiting, and it ends when the container restarts
+// or the server closes it. It's opened again after the delay, the delay is doubled up to the max one
+// while the stream can't be opened.
+const (
+	logsReconnectDelay    = time.Second
+	logsReconnectMaxDelay = 30 * time.Second
+)
+
+// logTarget is a container which logs are shown. Empty container name means all containers of the pod.
+type logTarget struct {
+	title     string
+	container string
+}
+
+// getLogTargets returns containers, init containers and "all containers" target at the end.
+func getLogTargets(spec domain.PodSpec) []logTarget {
+	targets := make([]logTarget, 0, len(spec.Containers)+len(spec.InitContainers)+1)
+	for i := range spec.Containers {
+		targets = append(targets, logTarget{
+			title:     spec.Containers[i].Name,
+			container: spec.Containers[i].Name,
+		})
+	}
+	for i := range spec.InitContainers {
+		targets = append(targets, logTarget{
+			title:     initContainerPrefix + spec.InitContainers[i].Name,
+			container: spec.InitContainers[i].Name,
+		})
+	}
+
+	return append(targets, logTarget{title: allContainersTitle})
+}
+
+func (m *Model) nextLogTarget() {
+	p := m.getCurrentPod()
+	if p == nil {
+		return
+	}
+
+	m.logTarget = (m.logTarget + 1) % len(getLogTargets(p.Spec))
+	m.followLogs()
+}
+
+func (m *Model) currentLogTarget(p *pod) logTarget {
+	targets := getLogTargets(p.Spec)
+	if m.logTarget >= len(targets) {
+		m.logTarget = 0
+	}
+
+	return targets[m.logTarget]
+}
+
+// followLogs cancels the previous logs stream and starts following logs of the current pod.
+// In "all containers" mode it starts a stream per container, lines are prefixed with a container name.
+func (m *Model) followLogs() {
+	m.stopLogs()
+	m.infobar.SetContent("")
+	m.infobar.ResetView()
+
+	p := m.getCurrentPod()
+	if p == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelLogs = cancel
+	m.logSession++
+	m.logNamespace, m.logPod = p.Namespace, p.Name
+
+	namespace := p.Namespace
+	target := m.currentLogTarget(p)
+	if target.container != "" {
+		if m.previousLogs {
+			m.infobar.AppendLines(renderLastTermination(p.StatusInfo.ContainerStatuses, target.container, "")...)
+		}
+		go m.streamLogs(ctx, m.logSession, namespace, p.Name, m.logOptions(target.container), "")
+
+		return
+	}
+
+	targets := getLogTargets(p.Spec)
+	for i := range targets {
+		if targets[i].container == "" {
+			continue
+		}
+		prefix := fmt.Sprintf("[%s] ", targets[i].title)
+		if m.previousLogs {
+			m.infobar.AppendLines(renderLastTermination(p.StatusInfo.ContainerStatuses, targets[i].container, prefix)...)
+		}
+		go m.streamLogs(ctx, m.logSession, namespace, p.Name, m.logOptions(targets[i].container), prefix)
+	}
+}
+
+func (m *Model) togglePreviousLogs() {
+	m.previousLogs = !m.previousLogs
+	m.followLogs()
+}
+
+func (m *Model) logOptions(container string) domain.LogOptions {
+	// previous container instance is terminated, there is nothing to follow
+	return domain.LogOptions{
+		Follow:    !m.previousLogs,
+		Container: container,
+		Previous:  m.previousLogs,
+	}
+}
+
+func (m *Model) streamLogs(ctx context.Context, session int, namespace, name string, opts domain.LogOptions, prefix string) {
+	send := func(line string) bool {
+		select {
+		case m.events <- logMsg{session: session, line: prefix + line}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	lines, err := m.repo.StreamPodLogs(ctx, namespace, name, opts)
+	if err != nil {
+		send(fmt.Sprintf("can't get logs: %v", err))
+
+		// logs of the previous instance won't appear later, only the followed stream is retried
+		if !opts.Follow {
+			return
+		}
+		if lines = m.reopenLogs(ctx, namespace, name, opts); lines == nil {
+			return
+		}
+	}
+
+	var last time.Time
+	for {
+		for line := range lines {
+			// since time has seconds precision, the shown lines of the last second are sent again
+			if !opts.SinceTime.IsZero() && !line.Time.IsZero() && !line.Time.After(opts.SinceTime) {
+				continue
+			}
+			if !line.Time.IsZero() {
+				last = line.Time
+			}
+			if !send(line.Text) {
+				return
+			}
+		}
+
+		// logs of the previous instance are complete, only the followed stream is opened again
+		if !opts.Follow || ctx.Err() != nil {
+			return
+		}
+
+		// the lines written after the last shown one are requested. The time is taken from the line,
+		// not from the local clock: it's the clock of the node the logs are requested by.
+		if !last.IsZero() {
+			opts.SinceTime = last
+		}
+		if lines = m.reopenLogs(ctx, namespace, name, opts); lines == nil {
+			return
+		}
+	}
+}
+
+// reopenLogs opens the logs stream which can't be opened or has ended, it retries with the growing delay
+// until ctx is done. It returns nil if ctx is done.
+func (m *Model) reopenLogs(ctx context.Context, namespace, name string, opts domain.LogOptions) <-chan domain.LogLine {
+	delay := logsReconnectDelay
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+
+		lines, err := m.repo.StreamPodLogs(ctx, namespace, name, opts)
+		if err == nil {
+			return lines
+		}
+
+		if delay *= 2; delay > logsReconnectMaxDelay {
+			delay = logsReconnectMaxDelay
+		}
+	}
+}
+
+func (m *Model) stopLogs() {
+	if m.cancelLogs != nil {
+		m.cancelLogs()
+		m.cancelLogs = nil
+	}
+	m.logNamespace, m.logPod = "", ""
+}
+
+func (m *Model) renderContainerPicker() string {
+	p := m.getCurrentPod()
+	if p == nil {
+		return "\n"
+	}
+
+	targets := getLogTargets(p.Spec)
+	titles := make([]string, len(targets))
+	for i := range targets {
+		if i == m.logTarget {
+			titles[i] = m.app.Styles.SelectedText.Render(targets[i].title)
+
+			continue
+		}
+		titles[i] = m.app.Styles.InactiveText.Render(targets[i].title)
+	}
+
+	picker := strings.Join(titles, minColumnGap)
+	if m.previousLogs {
+		picker += minColumnGap + m.app.Styles.NamespaceSign.Render(previousTitle)
+	}
+
+	return picker + "\n"
+}
+
+// renderLastTermination renders the reason and the exit code of the last container termination.
+// Lines are plain text on purpose: info bar cuts lines by runes on horizontal scroll.
+func renderLastTermination(ss []domain.ContainerStatus, container, prefix string) []string {
+	for i := range ss {
+		if ss[i].Name != container {
+			continue
+		}
+
+		t := ss[i].LastTermination
+		if t == nil {
+			return []string{prefix + "No previous termination"}
+		}
+
+		lines := []string{
+			fmt.Sprintf("%sLast termination: %s, exit code %d, finished at %s",
+				prefix, t.Reason, t.ExitCode, t.FinishedAt.Format(shared.TimeFormat)),
+		}
+		if t.Message != "" {
+			lines = append(lines, prefix+t.Message)
+		}
+
+		return append(lines, prefix+strings.Repeat("─", len("Last termination")))
+	}
+
+	return []string{prefix + "No container status"}
+}
@@ -0,0 +1,84 @@
+package pods
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+// metricsInterval is how often the usage of pods is updated. Metrics server scrapes kubelets every 15 seconds by default.
+const metricsInterval = 15 * time.Second
+
+// metricsTickMsg triggers the metrics update.
+type metricsTickMsg struct{}
+
+// metricsMsg keeps the usage of pods of the namespace.
+type metricsMsg struct {
+	generation int
+	metrics    []domain.PodMetrics
+	err        error
+}
+
+func metricsTick() tea.Cmd {
+	return tea.Tick(metricsInterval, func(time.Time) tea.Msg {
+		return metricsTickMsg{}
+	})
+}
+
+// loadMetrics requests the usage of pods of the listed namespace in background.
+func (m *Model) loadMetrics() tea.Cmd {
+	namespace := m.app.WorkloadsNamespace()
+	generation := m.loader.Generation()
+
+	return func() tea.Msg {
+		metrics, err := m.repo.GetPodMetrics(context.Background(), namespace)
+
+		return metricsMsg{
+			generation: generation,
+			metrics:    metrics,
+			err:        err,
+		}
+	}
+}
+
+func (m *Model) applyMetrics(msg metricsMsg) {
+	// namespace or context has been changed since the request was sent
+	if msg.generation != m.loader.Generation() {
+		return
+	}
+
+	m.setMetrics(msg.metrics, msg.err)
+
+	items := m.list.Items()
+	for i := range items {
+		if p, ok := items[i].(*pod); ok {
+			m.setUsage(p)
+		}
+	}
+
+	m.setInfoContent()
+}
+
+// setMetrics keeps the usage of pods, it's added to the pods which are received later from the watch.
+func (m *Model) setMetrics(metrics []domain.PodMetrics, err error) {
+	m.metricsErr = err
+	m.metrics = make(map[string]*domain.PodMetrics, len(metrics))
+	for i := range metrics {
+		m.metrics[metrics[i].Namespace+"/"+metrics[i].Name] = &metrics[i]
+	}
+}
+
+func (m *Model) setUsage(p *pod) {
+	p.Metrics = m.metrics[p.Namespace+"/"+p.Name]
+	p.MetricsErr = m.metricsErr
+}
+
+// newPod creates the list item of the pod with the known usage.
+func (m *Model) newPod(p domain.Pod) *pod {
+	item := newPod(p, m.app.AllNamespaces)
+	m.setUsage(item)
+
+	return item
+}
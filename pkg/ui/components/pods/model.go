@@ -2,9 +2,7 @@ package pods
 
 import (
 	"context"
-	"fmt"
 	"strings"
-	"sync"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
@@ -13,8 +11,10 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/tty2/kubic/pkg/domain"
 	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/confirm"
 	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
 	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/prompt"
 )
 
 type focused int
@@ -23,31 +23,71 @@ const (
 	listInFocus focused = iota
 	infoInFocus
 	logInFocus
+	eventsInFocus
 )
 
-// The gap between list and info bar content:
-// it consists from 3 list right padding + vertical line + left info bar padding (minColumnGap).
-const listToInfoContentGap = 6
-
 type podsRepo interface {
-	GetPods(ctx context.Context, namespace string) ([]domain.Pod, error)
-	PodsLog(ctx context.Context, namespace, name string) []byte
+	StreamPodLogs(ctx context.Context, namespace, name string, opts domain.LogOptions) (<-chan domain.LogLine, error)
+	WatchPods(ctx context.Context, namespace string) <-chan domain.PodChange
+	GetObjectEvents(ctx context.Context, namespace, kind, name string) ([]domain.Event, error)
+	DeletePod(ctx context.Context, namespace, name string, opts domain.DeleteOptions) error
+	Exec(namespace, name string, opts domain.ExecOptions) error
+	StartPortForward(ctx context.Context, target domain.PortForwardTarget, localPort int) (domain.PortForward, error)
+	GetPodMetrics(ctx context.Context, namespace string) ([]domain.PodMetrics, error)
+	GetReplicaSetController(ctx context.Context, namespace, name string) (domain.OwnerInfo, error)
+}
+
+// changeMsg is a pod change received from the namespace watch.
+type changeMsg struct {
+	generation int
+	change     domain.PodChange
+}
+
+// logMsg is a line of the pod logs stream.
+// Session is used to drop lines of already canceled streams.
+type logMsg struct {
+	session int
+	line    string
 }
 
 // Model for pods.
-// Mutex is necessary here.
-// We must synchronize UpdateList function call and View function call on update namespaces.
-// In order to make user interface faster on update namespace we call update callbacks in another goroutine.
-// namespaces/model.go package Model.setActive() function has go m.app.OnUpdateNamespace()
-// If user switch tab faster than k8s makes call to update list, user will get outdated list.
-// Mutex helps us to wait for k8s response and update list before view.
 type Model struct {
-	app     *shared.App
-	list    list.Model
-	repo    podsRepo
-	mu      sync.Mutex
-	focused focused
-	infobar *infobar.Model
+	app         *shared.App
+	list        list.Model
+	repo        podsRepo
+	focused     focused
+	infobar     *infobar.Model
+	loader      *shared.Loader
+	confirm     *confirm.Model
+	prompt      *prompt.Model
+	events      chan tea.Msg
+	cancelWatch context.CancelFunc
+	// pods are all the pods received from the watch by `namespace/name`. The list is built from them
+	// when the watch is synced or the owner is changed, the previous list is shown until the watch is synced.
+	pods       map[string]domain.Pod
+	synced     bool
+	cancelLogs context.CancelFunc
+	logSession int
+	// logNamespace and logPod are the pod which logs are shown.
+	logNamespace string
+	logPod       string
+	// logTarget is an index of the container in `logTargets` list which logs are shown.
+	logTarget    int
+	previousLogs bool
+	// eventsNamespace and eventsPod are the pod which events are shown or being loaded.
+	eventsNamespace string
+	eventsPod       string
+	podEvents       *objectEventsMsg
+	// status is the result of the last action.
+	status *actionMsg
+	// metrics is the usage of pods by `namespace/name`, metricsErr is set if the metrics can't be got.
+	metrics    map[string]*domain.PodMetrics
+	metricsErr error
+	sorter     *shared.Sorter
+	// owner is set when pods of a workload are drilled down to, only its pods are listed then.
+	owner *shared.PodsOwner
+	// resolvedReplicaSets are the replica sets which controllers are requested while pods of the deployment are shown.
+	resolvedReplicaSets map[string]bool
 }
 
 func New(app *shared.App, repo podsRepo) (*Model, error) {
@@ -55,22 +95,23 @@ func New(app *shared.App, repo podsRepo) (*Model, error) {
 		repo:    repo,
 		app:     app,
 		infobar: infobar.New(),
+		loader:  shared.NewLoader(app.Styles.NamespaceSign),
+		confirm: confirm.New(),
+		prompt:  prompt.New(),
+		events:  make(chan tea.Msg),
+		sorter:  shared.NewSorter(sortColumns()...),
 	}
 
 	itemsModel := list.New([]list.Item{}, &pod{
 		Styles: app.Styles,
 	}, 0, 0)
-	itemsModel.SetFilteringEnabled(false)
-	itemsModel.SetShowFilter(false)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
 	itemsModel.SetShowTitle(false)
 	itemsModel.SetShowStatusBar(false)
 	itemsModel.SetShowHelp(false)
 	itemsModel.Paginator.Type = paginator.Dots
 	m.list = itemsModel
-	m.UpdateList()
-	m.app.AddUpdateNamespaceCallback(m.UpdateList)
-	m.app.AddUpdateNamespaceCallback(m.resetFocus)
-	m.app.AddUpdateNamespaceCallback(m.setInfoContent)
+	m.infobar.SetMaxLines(maxLogLines)
 
 	m.setInfoBarHeight()
 
@@ -78,14 +119,121 @@ func New(app *shared.App, repo podsRepo) (*Model, error) {
 }
 
 func (m *Model) Init() tea.Cmd {
-	return nil
+	return tea.Batch(m.waitForEvent(), m.watch(), metricsTick())
 }
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
+	switch msg := msg.(type) {
+	case shared.ContextChangedMsg:
+		// the watch is started again on the namespace change which follows the switch
+		m.stopWatch()
+		m.clearOwner()
+		shared.ClearList(&m.list, m.loader)
+		m.resetFocus()
+		m.setInfoContent()
+
+		return m, cmd
+	case shared.NamespaceChangedMsg:
+		m.clearOwner()
+		m.resetFocus()
+
+		return m, m.watch()
+	case changeMsg:
+		return m, tea.Batch(m.waitForEvent(), m.applyChange(msg))
+	case logMsg:
+		if msg.session == m.logSession && m.focused == logInFocus {
+			m.infobar.AppendLines(msg.line)
+		}
+
+		return m, m.waitForEvent()
+	case objectEventsMsg:
+		m.applyEvents(msg)
+
+		return m, m.waitForEvent()
+	case actionMsg:
+		m.status = &msg
+		m.setInfoContent()
+
+		return m, cmd
+	case forwardMsg:
+		m.askLocalPort(msg.target)
+
+		return m, cmd
+	case metricsTickMsg:
+		return m, tea.Batch(m.loadMetrics(), metricsTick())
+	case metricsMsg:
+		m.applyMetrics(msg)
+
+		return m, cmd
+	case shared.ShowPodsMsg:
+		return m, m.showOwnerPods(msg.Owner)
+	case replicaSetControllerMsg:
+		m.applyReplicaSetController(msg)
+
+		return m, cmd
+	}
+
+	if cmd = m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	if m.confirm.Open() {
+		_, cmd = m.confirm.Update(msg)
+
+		return m, cmd
+	}
+
+	if m.prompt.Open() {
+		_, cmd = m.prompt.Update(msg)
+
+		return m, cmd
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
 	if msg, ok := msg.(tea.KeyMsg); ok {
 		switch {
+		case m.focused == logInFocus && key.Matches(msg, m.app.KeyMap.NextContainer):
+			m.nextLogTarget()
+
+			return m, cmd
+		case m.focused == logInFocus && key.Matches(msg, m.app.KeyMap.PreviousLogs):
+			m.togglePreviousLogs()
+
+			return m, cmd
+		case m.owner != nil && key.Matches(msg, m.app.KeyMap.Back):
+			return m, m.back()
+		case key.Matches(msg, m.app.KeyMap.ExecShell):
+			return m, m.execShell()
+		case m.focused != logInFocus && key.Matches(msg, m.app.KeyMap.DeletePod):
+			m.askDelete()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.PortForward):
+			m.askPortForward()
+
+			return m, cmd
+		case m.focused == eventsInFocus && key.Matches(msg, m.app.KeyMap.RefreshEvents):
+			m.eventsPod = ""
+			m.setInfoContent()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.SortColumn):
+			m.sort(m.sorter.NextColumn)
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.ReverseSort):
+			m.sort(m.sorter.Reverse)
+
+			return m, cmd
 		case key.Matches(msg, m.app.KeyMap.FocusRight):
 			m.changeFocusRight()
 
@@ -107,27 +255,27 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+// DialogOpen returns true if the confirmation dialog or the prompt waits for the answer.
+func (m *Model) DialogOpen() bool {
+	return m.confirm.Open() || m.prompt.Open()
+}
+
 func (m *Model) View() string {
 	m.setInfoBarHeight()
 
 	var s strings.Builder
 	s.WriteString("\n")
-	header := getHeader()
-	header = fmt.Sprintf("%s%s%s",
-		header,
-		strings.Repeat(" ", shared.Max(
-			len(minColumnGap),
-			m.app.GUI.ScreenWidth-lipgloss.Width(header)-lipgloss.Width(m.app.CurrentNamespace)-m.app.Styles.TextRightMargin)),
-		m.app.CurrentNamespace)
-	s.WriteString(m.app.Styles.InactiveText.Render(header))
+	s.WriteString(m.tableHeader())
 	s.WriteString("\n")
 	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
 	s.WriteString("\n")
 	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
 
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
 	s.WriteString(
 		m.app.Styles.InitStyle.Render(
 			lipgloss.JoinHorizontal(
@@ -140,30 +288,135 @@ func (m *Model) View() string {
 	return s.String()
 }
 
-func (m *Model) UpdateList() {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+// watch restarts watching pods of the current namespace or of all namespaces.
+// Changes are forwarded to the model events channel which is read by `waitForEvent` command.
+// The watch lists pods first, so the list is loaded by it too: the spinner is shown until it's synced.
+// The usage of pods is loaded along with them.
+func (m *Model) watch() tea.Cmd {
+	m.stopWatch()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelWatch = cancel
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	namespace := m.app.WorkloadsNamespace()
+	changes := m.repo.WatchPods(ctx, namespace)
+
+	go func() {
+		for change := range changes {
+			m.events <- changeMsg{
+				generation: generation,
+				change:     change,
+			}
+		}
+	}()
 
-	pods, err := m.repo.GetPods(context.Background(), m.app.CurrentNamespace)
-	if err != nil {
-		return
+	return tea.Batch(start, m.loadMetrics())
+}
+
+// stopWatch cancels the watch, changes it has sent already are dropped by the generation.
+func (m *Model) stopWatch() {
+	if m.cancelWatch != nil {
+		m.cancelWatch()
+		m.cancelWatch = nil
+	}
+	m.pods = make(map[string]domain.Pod)
+	m.synced = false
+}
+
+func (m *Model) waitForEvent() tea.Cmd {
+	return func() tea.Msg {
+		return <-m.events
+	}
+}
+
+// applyChange keeps the watched pod and applies it to the list once the watch is synced.
+func (m *Model) applyChange(msg changeMsg) tea.Cmd {
+	// namespace or context has been changed since the event was sent
+	if msg.generation != m.loader.Generation() {
+		return nil
+	}
+
+	key := msg.change.Pod.Namespace + "/" + msg.change.Pod.Name
+	switch msg.change.Type {
+	case domain.Synced:
+		m.synced = true
+		m.loader.Stop()
+		m.setItems()
+
+		return nil
+	case domain.Failed:
+		// the watch retries the list, the previous list is kept meanwhile
+		m.loader.Stop()
+
+		return shared.LoadError(shared.PodsTab, msg.change.Err)
+	case domain.Added, domain.Modified:
+		m.pods[key] = msg.change.Pod
+	case domain.Deleted:
+		delete(m.pods, key)
+	}
+
+	if !m.synced {
+		return nil
+	}
+
+	index := m.indexOf(msg.change.Pod.Namespace, msg.change.Pod.Name)
+	switch msg.change.Type {
+	case domain.Added, domain.Modified:
+		if index < 0 && m.owner != nil && !m.owner.Owns(&msg.change.Pod) {
+			return m.resolveReplicaSets(&msg.change.Pod)
+		}
+		p := m.newPod(msg.change.Pod)
+		if index < 0 {
+			shared.ApplyFilter(&m.list, m.list.InsertItem(len(m.list.Items()), p))
+		} else {
+			shared.ApplyFilter(&m.list, m.list.SetItem(index, p))
+		}
+	case domain.Deleted:
+		if index >= 0 {
+			shared.RemoveItem(&m.list, index)
+		}
+	}
+	shared.SortList(&m.list, m.sorter)
+
+	if m.focused != logInFocus {
+		// events are loaded again only if another pod is selected now
+		m.setInfoContent()
+	} else if p := m.getCurrentPod(); p == nil || p.Namespace != m.logNamespace || p.Name != m.logPod {
+		// followed pod has gone, show logs of the pod that is selected now
+		m.followLogs()
+	}
+
+	return nil
+}
+
+// setItems replaces the list with the watched pods, only the pods of the owner are kept while it's drilled down to.
+func (m *Model) setItems() {
+	items := make([]list.Item, 0, len(m.pods))
+	for key := range m.pods {
+		p := m.pods[key]
+		if m.owner != nil && !m.owner.Owns(&p) {
+			continue
+		}
+		items = append(items, m.newPod(p))
 	}
+	m.sorter.Sort(items)
 
-	items := make([]list.Item, len(pods))
-	for i := range pods {
-		items[i] = &pod{
-			Name:       pods[i].Name,
-			Ready:      pods[i].Ready,
-			Status:     pods[i].Status,
-			Restarts:   pods[i].Restarts,
-			Age:        pods[i].Age,
-			Meta:       pods[i].Meta,
-			Spec:       pods[i].Spec,
-			StatusInfo: pods[i].StatusInfo,
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	m.setInfoContent()
+}
+
+// indexOf returns index of the pod in the list. Pods of all namespaces can have the same names.
+func (m *Model) indexOf(namespace, name string) int {
+	items := m.list.Items()
+	for i := range items {
+		if p, ok := items[i].(*pod); ok && p.Namespace == namespace && p.Name == name {
+			return i
 		}
 	}
 
-	m.list.SetItems(items)
+	return -1
 }
 
 func (m *Model) changeFocusRight() {
@@ -171,14 +424,24 @@ func (m *Model) changeFocusRight() {
 	case listInFocus:
 		m.focused = infoInFocus
 	case infoInFocus:
-		m.focused = logInFocus
+		m.openLogs()
+	case logInFocus:
+		m.stopLogs()
+		m.focused = eventsInFocus
+		m.eventsPod = ""
 		m.setInfoContent()
+		m.infobar.ResetIndent()
+		m.infobar.ResetView()
 	}
 }
 
 func (m *Model) changeFocusLeft() {
 	switch m.focused {
+	case eventsInFocus:
+		m.infobar.ResetIndent()
+		m.openLogs()
 	case logInFocus:
+		m.stopLogs()
 		m.focused = infoInFocus
 		m.setInfoContent()
 		m.infobar.ResetIndent()
@@ -190,19 +453,56 @@ func (m *Model) changeFocusLeft() {
 	}
 }
 
+// openLogs moves focus to logs and starts following logs of the first container.
+func (m *Model) openLogs() {
+	m.focused = logInFocus
+	m.logTarget = 0
+	m.previousLogs = false
+	m.followLogs()
+}
+
 func (m *Model) resetFocus() {
+	m.stopLogs()
+	m.status = nil
+	m.confirm.Close()
+	m.prompt.Close()
 	m.focused = listInFocus
 	m.list.ResetSelected()
 }
 
 func (m *Model) renderInfoBar() string {
+	if m.confirm.Open() {
+		return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(
+			m.confirm.View(
+				m.app.InfoBarWidth(m.header()),
+				m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+				m.app.Styles.MainText,
+			),
+		)
+	}
+
+	if m.prompt.Open() {
+		return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(
+			m.prompt.View(
+				m.app.InfoBarWidth(m.header()),
+				m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+				m.app.Styles.MainText,
+			),
+		)
+	}
+
 	var infoBarData string
 	switch m.focused {
 	case listInFocus:
 		infoData := m.infobar.View()
 		infoBarData = m.app.Styles.InactiveText.Render(infoData)
-	case infoInFocus, logInFocus:
+	case infoInFocus, eventsInFocus:
 		infoBarData = m.infobar.View()
+	case logInFocus:
+		infoBarData = lipgloss.JoinVertical(lipgloss.Left,
+			m.renderContainerPicker(),
+			m.infobar.View(),
+		)
 	}
 
 	info := lipgloss.JoinVertical(lipgloss.Left,
@@ -213,6 +513,11 @@ func (m *Model) renderInfoBar() string {
 	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
 }
 
+// header returns the list header, the namespace column is added when pods of all namespaces are listed.
+func (m *Model) header() string {
+	return getHeader(m.app.AllNamespaces, m.sorter)
+}
+
 func (m *Model) getCurrentPod() *pod {
 	item := m.list.SelectedItem()
 	p, ok := item.(*pod)
@@ -250,27 +555,36 @@ func (m *Model) renderInfoBarTabs() string {
 func (m *Model) setInfoContent() {
 	pod := m.getCurrentPod()
 	if pod == nil {
-		m.infobar.SetContent("")
+		m.infobar.SetContent(m.renderStatus())
 
 		return
 	}
 
+	// logs content is filled by the logs stream
 	if m.focused == logInFocus {
-		m.infobar.SetContent(string(m.repo.PodsLog(context.Background(), m.app.CurrentNamespace, pod.Name)))
+		return
+	}
+
+	if m.focused == eventsInFocus {
+		m.setEventsContent(pod)
 
 		return
 	}
 
 	pod.Styles = m.app.Styles
 	m.infobar.SetContent(
-		m.getCurrentPod().renderInfo(),
+		m.renderStatus() + m.getCurrentPod().renderInfo(),
 	)
 }
 
 func (m *Model) setInfoBarHeight() {
+	height := m.app.GUI.Areas.MainContent.Height - tableHeaderHeight
+	if m.focused == logInFocus {
+		height -= containerPickerHeight
+	}
 	m.infobar.SetWH(
-		m.app.GUI.ScreenWidth-lipgloss.Width(getHeader())-listToInfoContentGap,
-		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+		m.app.InfoBarWidth(m.header()),
+		height,
 	)
 	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
 }
@@ -279,6 +593,7 @@ func getInfoTabs() []focused {
 	return []focused{
 		infoInFocus,
 		logInFocus,
+		eventsInFocus,
 	}
 }
 
@@ -288,6 +603,8 @@ func (f focused) String() string {
 		return "Info"
 	case logInFocus:
 		return "Logs"
+	case eventsInFocus:
+		return "Events"
 	default:
 		return ""
 	}
@@ -14,17 +14,23 @@ import (
 )
 
 const (
-	nameHeader        = "Name"
-	readyHeader       = "Ready"
-	statusHeader      = "Status"
-	restartsHeader    = "Restarts"
-	ageHeader         = "Age"
-	minColumnGap      = "  "
-	nameColumnLen     = 20
-	readyColumnLen    = 7
-	statusColumnLen   = 9 // the longest status `Succeeded`
-	restartsColumnLen = len(restartsHeader)
-	tableHeaderHeight = 3
+	namespaceHeader    = "Namespace"
+	nameHeader         = "Name"
+	readyHeader        = "Ready"
+	statusHeader       = "Status"
+	restartsHeader     = "Restarts"
+	cpuHeader          = "CPU"
+	memoryHeader       = "Mem"
+	ageHeader          = "Age"
+	minColumnGap       = "  "
+	namespaceColumnLen = 16
+	nameColumnLen      = 20
+	readyColumnLen     = 7
+	statusColumnLen    = 11                      // the longest status `Terminating`
+	restartsColumnLen  = len(restartsHeader) + 1 // room for the sort sign
+	cpuColumnLen       = 6                       // 12345m
+	memoryColumnLen    = 7                       // 12345Mi
+	tableHeaderHeight  = 3
 )
 
 // nolint gochecknoglobals: used here on purpose
@@ -32,20 +38,41 @@ var boldText = lipgloss.NewStyle().Bold(true)
 
 type (
 	pod struct {
-		Name       string
-		Ready      string
-		Status     string
-		Restarts   int
-		Age        string
-		Meta       domain.PodMeta
-		Spec       domain.PodSpec
-		StatusInfo domain.PodStatusInfo
+		Name      string
+		Namespace string
+		// ShowNamespace adds the namespace column, it's shown when pods of all namespaces are listed.
+		ShowNamespace bool
+		Ready         string
+		Status        string
+		Restarts      int
+		Age           string
+		Meta          domain.PodMeta
+		Spec          domain.PodSpec
+		StatusInfo    domain.PodStatusInfo
+		// Metrics is the current usage of the pod, MetricsErr is set if metrics can't be got.
+		Metrics    *domain.PodMetrics
+		MetricsErr error
 		Styles     *themes.Styles
 	}
 )
 
+func newPod(p domain.Pod, showNamespace bool) *pod {
+	return &pod{
+		Name:          p.Name,
+		Namespace:     p.Namespace,
+		ShowNamespace: showNamespace,
+		Ready:         p.Ready,
+		Status:        p.Status,
+		Restarts:      p.Restarts,
+		Age:           p.Age,
+		Meta:          p.Meta,
+		Spec:          p.Spec,
+		StatusInfo:    p.StatusInfo,
+	}
+}
+
 // FilterValue is used to set filter item and required for `list.Model` interface.
-func (p *pod) FilterValue() string { return p.Name }
+func (p *pod) FilterValue() string { return shared.FilterValueWithLabels(p.Name, p.Meta.Labels) }
 func (p *pod) Height() int         { return 1 }
 func (p *pod) Spacing() int        { return 1 }
 func (p *pod) Update(msg tea.Msg, m *list.Model) tea.Cmd {
@@ -61,20 +88,31 @@ func (p *pod) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
 	name := shared.GetTextWithLen(s.Name, nameColumnLen)
 
 	var row strings.Builder
+	if s.ShowNamespace {
+		row.WriteString(shared.GetTextWithLen(s.Namespace, namespaceColumnLen))
+		row.WriteString(minColumnGap)
+	}
 	row.WriteString(name)
 	row.WriteString(minColumnGap)
 
 	row.WriteString(s.Ready)
-	row.WriteString(strings.Repeat(" ", readyColumnLen-lipgloss.Width(s.Ready)))
+	row.WriteString(strings.Repeat(" ", shared.Max(0, readyColumnLen-lipgloss.Width(s.Ready))))
 	row.WriteString(minColumnGap)
 
 	row.WriteString(s.Status)
-	row.WriteString(strings.Repeat(" ", statusColumnLen-lipgloss.Width(s.Status)))
+	// the status is not cut: an unexpected long one shifts the row, but it's shown
+	row.WriteString(strings.Repeat(" ", shared.Max(0, statusColumnLen-lipgloss.Width(s.Status))))
 	row.WriteString(minColumnGap)
 
 	restarts := fmt.Sprintf("%d", s.Restarts)
 	row.WriteString(restarts)
-	row.WriteString(strings.Repeat(" ", restartsColumnLen-lipgloss.Width(restarts)))
+	row.WriteString(strings.Repeat(" ", shared.Max(0, restartsColumnLen-lipgloss.Width(restarts))))
+	row.WriteString(minColumnGap)
+
+	cpu, memory := s.usage()
+	row.WriteString(shared.GetTextWithLen(cpu, cpuColumnLen))
+	row.WriteString(minColumnGap)
+	row.WriteString(shared.GetTextWithLen(memory, memoryColumnLen))
 	row.WriteString(minColumnGap)
 
 	row.WriteString(s.Age)
@@ -88,27 +126,44 @@ func (p *pod) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
 	}
 }
 
-func getHeader() string {
+func getHeader(showNamespace bool, sorter *shared.Sorter) string {
 	var header strings.Builder
 	header.WriteString(minColumnGap)
 
-	header.WriteString(nameHeader)
-	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	if showNamespace {
+		header.WriteString(namespaceHeader)
+		header.WriteString(strings.Repeat(" ", namespaceColumnLen-len(namespaceHeader)))
+		header.WriteString(minColumnGap)
+	}
+
+	nameTitle := sorter.Title(nameHeader)
+	header.WriteString(nameTitle)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-lipgloss.Width(nameTitle)))
 	header.WriteString(minColumnGap)
 
 	header.WriteString(readyHeader)
 	header.WriteString(strings.Repeat(" ", readyColumnLen-len(readyHeader)))
 	header.WriteString(minColumnGap)
 
-	header.WriteString(statusHeader)
-	header.WriteString(strings.Repeat(" ", statusColumnLen-len(statusHeader)))
+	statusTitle := sorter.Title(statusHeader)
+	header.WriteString(statusTitle)
+	header.WriteString(strings.Repeat(" ", statusColumnLen-lipgloss.Width(statusTitle)))
 	header.WriteString(minColumnGap)
 
-	header.WriteString(restartsHeader)
-	header.WriteString(strings.Repeat(" ", restartsColumnLen-len(restartsHeader)))
+	restartsTitle := sorter.Title(restartsHeader)
+	header.WriteString(restartsTitle)
+	header.WriteString(strings.Repeat(" ", restartsColumnLen-lipgloss.Width(restartsTitle)))
 	header.WriteString(minColumnGap)
 
-	header.WriteString(ageHeader)
+	header.WriteString(cpuHeader)
+	header.WriteString(strings.Repeat(" ", cpuColumnLen-len(cpuHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(memoryHeader)
+	header.WriteString(strings.Repeat(" ", memoryColumnLen-len(memoryHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(sorter.Title(ageHeader))
 
 	return header.String()
 }
@@ -120,6 +175,12 @@ func (p *pod) renderInfo() string {
 	info.WriteString(minColumnGap)
 	info.WriteString(p.Name)
 
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Namespace"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(p.Namespace)
+
 	info.WriteString("\n")
 	info.WriteString(boldText.Render("Created"))
 	info.WriteString("\n")
@@ -147,9 +208,23 @@ func (p *pod) renderInfo() string {
 
 	info.WriteString(renderStatusInfo(p.StatusInfo))
 
+	info.WriteString(shared.RenderContainersUsage(p.Spec.Containers, p.Metrics, p.MetricsErr))
+
 	return info.String()
 }
 
+// usage returns CPU and memory usage for the list columns.
+func (p *pod) usage() (cpu, memory string) {
+	switch {
+	case p.MetricsErr != nil:
+		return shared.MetricsUnavailable, shared.MetricsUnavailable
+	case p.Metrics == nil:
+		return shared.NoValue, shared.NoValue
+	default:
+		return shared.FormatCPU(p.Metrics.Usage.CPU), shared.FormatMemory(p.Metrics.Usage.Memory)
+	}
+}
+
 func renderSpec(spec domain.PodSpec) string {
 	var info strings.Builder
 
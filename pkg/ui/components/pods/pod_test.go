@@ -0,0 +1,43 @@
+package pods
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+func Test_pod_Render(t *testing.T) {
+	t.Parallel()
+
+	render := func(status string) string {
+		styles := themes.GetStyle(themes.Theme{})
+		p := newPod(domain.Pod{Name: "app-1", Namespace: "default", Ready: "1/1", Status: status, Age: "5m"}, false)
+		p.Styles = &styles
+
+		var buf bytes.Buffer
+		p.Render(&buf, list.New([]list.Item{p}, p, 100, 10), 1, p)
+
+		return buf.String()
+	}
+
+	t.Run("terminating", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		row := render("Terminating")
+		rq.Contains(row, "Terminating")
+		// the status column fits it: the columns are aligned with the rows of the short status
+		rq.Equal(lipgloss.Width(render("Running")), lipgloss.Width(row))
+	})
+
+	t.Run("unexpected long status", func(t *testing.T) {
+		t.Parallel()
+
+		require.Contains(t, render("ContainerStatusUnknown"), "ContainerStatusUnknown")
+	})
+}
@@ -0,0 +1,76 @@
+package pods
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+// forwardMsg is sent when user has chosen the pod port, the local port is asked next.
+// The prompt can't be reopened from its own action, it's closed right after the action.
+type forwardMsg struct {
+	target domain.PortForwardTarget
+}
+
+// askPortForward asks for the pod port to forward to. It's not asked if the pod declares the only port.
+func (m *Model) askPortForward() {
+	p := m.getCurrentPod()
+	if p == nil {
+		return
+	}
+
+	target := domain.PortForwardTarget{
+		Kind:      domain.PortForwardTargetPod,
+		Namespace: p.Namespace,
+		Name:      p.Name,
+	}
+
+	ports := p.containerPorts()
+	if len(ports) == 1 {
+		target.Port = ports[0]
+		m.askLocalPort(target)
+
+		return
+	}
+
+	var initial int
+	if len(ports) > 0 {
+		initial = ports[0]
+	}
+	m.prompt.Ask(fmt.Sprintf("Forward to port of pod %s:", p.Name), initial, func(port int) tea.Cmd {
+		target.Port = port
+
+		return func() tea.Msg {
+			return forwardMsg{target: target}
+		}
+	})
+}
+
+// askLocalPort asks for the local port and starts the forward. The forward keeps running in the background,
+// it's managed in the port forwards tab.
+func (m *Model) askLocalPort(target domain.PortForwardTarget) {
+	question := fmt.Sprintf("Forward local port to %s (0 picks a free port):", target)
+	m.prompt.Ask(question, target.Port, func(localPort int) tea.Cmd {
+		return func() tea.Msg {
+			if _, err := m.repo.StartPortForward(context.Background(), target, localPort); err != nil {
+				return actionMsg{err: err}
+			}
+
+			return actionMsg{text: fmt.Sprintf("port forward to %s started", target)}
+		}
+	})
+}
+
+// containerPorts returns the ports declared by the pod containers.
+func (p *pod) containerPorts() []int {
+	var ports []int
+	for i := range p.Spec.Containers {
+		for _, port := range p.Spec.Containers[i].Ports {
+			ports = append(ports, port.Port)
+		}
+	}
+
+	return ports
+}
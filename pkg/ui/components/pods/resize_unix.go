@@ -0,0 +1,51 @@
+//go:build !windows
+
+package pods
+
+import (
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+// watchTerminalSize sends the current terminal size and then the new size on every SIGWINCH.
+// The returned function stops watching and closes the channel.
+func watchTerminalSize(w io.Writer) (sizes <-chan domain.TerminalSize, stop func()) {
+	ch := make(chan domain.TerminalSize, 1)
+	if size, ok := getTerminalSize(w); ok {
+		ch <- size
+	}
+
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(ch)
+
+		for {
+			select {
+			case <-winch:
+				size, ok := getTerminalSize(w)
+				if !ok {
+					continue
+				}
+				select {
+				case ch <- size:
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return ch, func() {
+		signal.Stop(winch)
+		close(done)
+	}
+}
@@ -0,0 +1,54 @@
+//go:build windows
+
+package pods
+
+import (
+	"io"
+	"time"
+
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+// resizePollInterval is the interval of the terminal size check, windows console has no resize signal.
+const resizePollInterval = 250 * time.Millisecond
+
+// watchTerminalSize sends the current terminal size and then the new size when it's changed.
+// The returned function stops watching and closes the channel.
+func watchTerminalSize(w io.Writer) (sizes <-chan domain.TerminalSize, stop func()) {
+	ch := make(chan domain.TerminalSize, 1)
+	last, ok := getTerminalSize(w)
+	if ok {
+		ch <- last
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(resizePollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				size, ok := getTerminalSize(w)
+				if !ok || size == last {
+					continue
+				}
+				last = size
+				select {
+				case ch <- size:
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return ch, func() {
+		close(done)
+	}
+}
@@ -0,0 +1,36 @@
+package pods
+
+import (
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/tty2/kubic/pkg/ui/shared"
+)
+
+// sortColumns returns the columns pods can be sorted by.
+// Age is sorted by the creation time, the formatted age loses the precision. The youngest pods go first.
+func sortColumns() []shared.SortColumn {
+	return []shared.SortColumn{
+		{Header: nameHeader, Less: byPod(func(a, b *pod) bool { return a.Name < b.Name })},
+		{Header: statusHeader, Less: byPod(func(a, b *pod) bool { return a.Status < b.Status })},
+		{Header: restartsHeader, Less: byPod(func(a, b *pod) bool { return a.Restarts < b.Restarts })},
+		{Header: ageHeader, Less: byPod(func(a, b *pod) bool { return a.Meta.Created.After(b.Meta.Created) })},
+	}
+}
+
+func byPod(less func(a, b *pod) bool) func(a, b list.Item) bool {
+	return func(a, b list.Item) bool {
+		podA, okA := a.(*pod)
+		podB, okB := b.(*pod)
+		if !okA || !okB {
+			return false
+		}
+
+		return less(podA, podB)
+	}
+}
+
+// sort changes the sort order with the function and sorts the list, the selected pod is kept.
+func (m *Model) sort(change func()) {
+	change()
+	shared.SortList(&m.list, m.sorter)
+	m.setInfoContent()
+}
@@ -0,0 +1,217 @@
+package portforwards
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	localHeader        = "Local"
+	namespaceHeader    = "Namespace"
+	targetHeader       = "Target"
+	statusHeader       = "Status"
+	receivedHeader     = "Received"
+	sentHeader         = "Sent"
+	minColumnGap       = "  "
+	localColumnLen     = 15 // localhost:65535
+	namespaceColumnLen = 16
+	targetColumnLen    = 30
+	statusColumnLen    = 8 // the longest status `Starting`
+	receivedColumnLen  = 9
+	sentColumnLen      = 9
+	tableHeaderHeight  = 3
+	localAddress       = "localhost"
+)
+
+// nolint gochecknoglobals: used here on purpose
+var boldText = lipgloss.NewStyle().Bold(true)
+
+type (
+	forward struct {
+		ID         int
+		Context    string
+		Target     domain.PortForwardTarget
+		Pod        string
+		LocalPort  int
+		RemotePort int
+		Status     string
+		BytesIn    int64
+		BytesOut   int64
+		Started    time.Time
+		Err        error
+		Styles     *themes.Styles
+	}
+)
+
+func newForward(f domain.PortForward) *forward {
+	return &forward{
+		ID:         f.ID,
+		Context:    f.Context,
+		Target:     f.Target,
+		Pod:        f.Pod,
+		LocalPort:  f.LocalPort,
+		RemotePort: f.RemotePort,
+		Status:     f.Status,
+		BytesIn:    f.BytesIn,
+		BytesOut:   f.BytesOut,
+		Started:    f.Started,
+		Err:        f.Err,
+	}
+}
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+func (f *forward) FilterValue() string { return f.Target.Namespace + ":" + f.Target.String() }
+func (f *forward) Height() int         { return 1 }
+func (f *forward) Spacing() int        { return 1 }
+func (f *forward) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (f *forward) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	pf, ok := listItem.(*forward)
+	if !ok {
+		return
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(pf.localAddress(), localColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(pf.Target.Namespace, namespaceColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(pf.Target.String(), targetColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(pf.Status, statusColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(formatBytes(pf.BytesIn), receivedColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(formatBytes(pf.BytesOut), sentColumnLen))
+
+	rowString := row.String()
+
+	switch {
+	case index == m.Index():
+		fmt.Fprint(w, f.Styles.SelectedText.Render(rowString))
+	case pf.Status == domain.PortForwardFailed:
+		fmt.Fprint(w, f.Styles.WarningText.Render(rowString))
+	case pf.Status == domain.PortForwardStopped:
+		fmt.Fprint(w, f.Styles.InactiveText.Render(rowString))
+	default:
+		fmt.Fprint(w, f.Styles.MainText.Render(rowString))
+	}
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(localHeader)
+	header.WriteString(strings.Repeat(" ", localColumnLen-len(localHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(namespaceHeader)
+	header.WriteString(strings.Repeat(" ", namespaceColumnLen-len(namespaceHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(targetHeader)
+	header.WriteString(strings.Repeat(" ", targetColumnLen-len(targetHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(statusHeader)
+	header.WriteString(strings.Repeat(" ", statusColumnLen-len(statusHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(receivedHeader)
+	header.WriteString(strings.Repeat(" ", receivedColumnLen-len(receivedHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(sentHeader)
+	header.WriteString(strings.Repeat(" ", sentColumnLen-len(sentHeader)))
+
+	return header.String()
+}
+
+func (f *forward) renderInfo() string {
+	var info strings.Builder
+
+	info.WriteString(boldText.Render("Local address: "))
+	info.WriteString(f.localAddress())
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Context: "))
+	info.WriteString(f.Context)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Namespace: "))
+	info.WriteString(f.Target.Namespace)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Target: "))
+	info.WriteString(f.Target.String())
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Pod: "))
+	info.WriteString(f.Pod)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Pod port: "))
+	info.WriteString(strconv.Itoa(f.RemotePort))
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Status: "))
+	if f.Status == domain.PortForwardFailed {
+		info.WriteString(f.Styles.WarningText.Render(f.Status))
+	} else {
+		info.WriteString(f.Status)
+	}
+	info.WriteString("\n")
+	if f.Err != nil {
+		info.WriteString(boldText.Render("Error: "))
+		info.WriteString(f.Err.Error())
+		info.WriteString("\n")
+	}
+	info.WriteString(boldText.Render("Started: "))
+	info.WriteString(f.Started.Format(shared.TimeFormat))
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Received: "))
+	info.WriteString(formatBytes(f.BytesIn))
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Sent: "))
+	info.WriteString(formatBytes(f.BytesOut))
+	info.WriteString("\n")
+
+	return info.String()
+}
+
+// localAddress returns the address to connect to. Random port is unknown until the forward is started.
+func (f *forward) localAddress() string {
+	if f.LocalPort == 0 {
+		return localAddress + ":-"
+	}
+
+	return localAddress + ":" + strconv.Itoa(f.LocalPort)
+}
+
+// formatBytes returns the size in the binary units: 512B, 1.5KiB, 20.0MiB.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatInt(n, 10) + "B"
+	}
+
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
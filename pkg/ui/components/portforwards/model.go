@@ -0,0 +1,346 @@
+package portforwards
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+)
+
+// refreshInterval is how often status and bytes counters of the forwards are updated.
+const refreshInterval = time.Second
+
+type focused int
+
+const (
+	listInFocus focused = iota
+	infoInFocus
+)
+
+type portForwardsRepo interface {
+	GetPortForwards() []domain.PortForward
+	StopPortForward(id int) error
+	RestartPortForward(ctx context.Context, id int) error
+	RemovePortForward(id int) error
+}
+
+// tickMsg triggers the list refresh.
+type tickMsg struct{}
+
+// actionMsg is a result of the action with a port forward.
+type actionMsg struct {
+	text string
+	err  error
+}
+
+// Model for the port forwards manager.
+// Port forwards are started from pods and services tabs and run in the background
+// until they are stopped here or kubic quits. They don't belong to the current namespace.
+type Model struct {
+	app     *shared.App
+	list    list.Model
+	repo    portForwardsRepo
+	mu      sync.Mutex
+	focused focused
+	infobar *infobar.Model
+	// status is the result of the last action.
+	status *actionMsg
+}
+
+func New(app *shared.App, repo portForwardsRepo) (*Model, error) {
+	m := Model{
+		repo:    repo,
+		app:     app,
+		infobar: infobar.New(),
+	}
+
+	itemsModel := list.New([]list.Item{}, &forward{
+		Styles: app.Styles,
+	}, 0, 0)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
+	itemsModel.SetShowTitle(false)
+	itemsModel.SetShowStatusBar(false)
+	itemsModel.SetShowHelp(false)
+	itemsModel.Paginator.Type = paginator.Dots
+	m.list = itemsModel
+	m.UpdateList()
+	m.setInfoContent()
+
+	m.setInfoBarHeight()
+
+	return &m, nil
+}
+
+func (m *Model) Init() tea.Cmd {
+	return tick()
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tickMsg:
+		m.UpdateList()
+		m.setInfoContent()
+
+		return m, tick()
+	case actionMsg:
+		m.status = &msg
+		m.UpdateList()
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.app.KeyMap.FocusRight):
+			m.changeFocusRight()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.FocusLeft):
+			m.changeFocusLeft()
+			m.infobar.ResetView()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.StopPortForward):
+			return m, m.stop()
+		case key.Matches(msg, m.app.KeyMap.RestartPortForward):
+			return m, m.restart()
+		case key.Matches(msg, m.app.KeyMap.RemovePortForward):
+			return m, m.remove()
+		}
+	}
+
+	if m.listInFocus() {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+	} else {
+		_, cmd = m.infobar.Update(msg)
+	}
+
+	return m, cmd
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+func (m *Model) View() string {
+	m.setInfoBarHeight()
+
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(), m.list, nil))
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s.WriteString(
+		m.app.Styles.InitStyle.Render(
+			lipgloss.JoinHorizontal(
+				lipgloss.Top,
+				m.app.Styles.ListRightBorder.Render(m.list.View()),
+				m.renderInfoBar(),
+			),
+		))
+
+	return s.String()
+}
+
+func (m *Model) UpdateList() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	forwards := m.repo.GetPortForwards()
+
+	items := make([]list.Item, len(forwards))
+	for i := range forwards {
+		items[i] = newForward(forwards[i])
+	}
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(refreshInterval, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m *Model) stop() tea.Cmd {
+	f := m.getCurrentForward()
+	if f == nil {
+		return nil
+	}
+
+	id, target := f.ID, f.Target.String()
+
+	return func() tea.Msg {
+		if err := m.repo.StopPortForward(id); err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("port forward to %s stopped", target)}
+	}
+}
+
+func (m *Model) restart() tea.Cmd {
+	f := m.getCurrentForward()
+	if f == nil {
+		return nil
+	}
+
+	id, target := f.ID, f.Target.String()
+
+	return func() tea.Msg {
+		if err := m.repo.RestartPortForward(context.Background(), id); err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("port forward to %s restarted", target)}
+	}
+}
+
+func (m *Model) remove() tea.Cmd {
+	f := m.getCurrentForward()
+	if f == nil {
+		return nil
+	}
+
+	id, target := f.ID, f.Target.String()
+
+	return func() tea.Msg {
+		if err := m.repo.RemovePortForward(id); err != nil {
+			return actionMsg{err: err}
+		}
+
+		return actionMsg{text: fmt.Sprintf("port forward to %s removed", target)}
+	}
+}
+
+func (m *Model) changeFocusRight() {
+	if m.listInFocus() {
+		m.focused = infoInFocus
+	}
+}
+
+func (m *Model) changeFocusLeft() {
+	if m.infoInFocus() {
+		m.focused = listInFocus
+	}
+}
+
+func (m *Model) listInFocus() bool {
+	return m.focused == listInFocus
+}
+
+func (m *Model) infoInFocus() bool {
+	return m.focused == infoInFocus
+}
+
+func (m *Model) renderInfoBar() string {
+	infoData := m.infobar.View()
+
+	if !m.infoInFocus() {
+		infoData = m.app.Styles.InactiveText.Render(infoData)
+	}
+
+	info := lipgloss.JoinVertical(lipgloss.Left,
+		m.renderInfoBarTabs(),
+		infoData,
+	)
+
+	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
+}
+
+func (m *Model) getCurrentForward() *forward {
+	item := m.list.SelectedItem()
+	f, ok := item.(*forward)
+	if !ok {
+		return nil
+	}
+
+	return f
+}
+
+func (m *Model) renderInfoBarTabs() string {
+	tabs := getInfoTabs()
+	titles := make([]string, len(tabs))
+	for i := range tabs {
+		if m.infoInFocus() {
+			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i])
+		} else {
+			titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i])
+		}
+	}
+
+	titlesStr := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		titles...,
+	)
+
+	gap := m.app.Styles.InfoGap.Render(
+		strings.Repeat(" ", shared.Max(0, m.app.GUI.ScreenWidth-lipgloss.Width(titlesStr))),
+	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, titlesStr, gap)
+}
+
+func (m *Model) setInfoContent() {
+	f := m.getCurrentForward()
+	if f == nil {
+		m.infobar.SetContent(m.renderStatus() + "No port forwards.\n" +
+			"Press " + m.app.KeyMap.PortForward.Keys()[0] + " on a pod or a service to start one.")
+
+		return
+	}
+	f.Styles = m.app.Styles
+	m.infobar.SetContent(m.renderStatus() + f.renderInfo())
+}
+
+func (m *Model) renderStatus() string {
+	switch {
+	case m.status == nil:
+		return ""
+	case m.status.err != nil:
+		return m.app.Styles.SelectedText.Render("Error: "+m.status.err.Error()) + "\n"
+	default:
+		return m.app.Styles.NamespaceSign.Render(m.status.text) + "\n"
+	}
+}
+
+func (m *Model) setInfoBarHeight() {
+	m.infobar.SetWH(
+		m.app.InfoBarWidth(getHeader()),
+		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+	)
+	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
+}
+
+func getInfoTabs() []string {
+	return []string{"Info"}
+}
@@ -0,0 +1,391 @@
+package secrets
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+)
+
+type focused int
+
+const (
+	listInFocus focused = iota
+	keysInFocus
+	valueInFocus
+)
+
+type secretsRepo interface {
+	GetSecrets(ctx context.Context, namespace string) ([]domain.Secret, error)
+}
+
+// listMsg is a result of the secrets loading.
+type listMsg struct {
+	generation int
+	secrets    []domain.Secret
+	err        error
+}
+
+// Model for secrets.
+type Model struct {
+	app     *shared.App
+	list    list.Model
+	repo    secretsRepo
+	focused focused
+	infobar *infobar.Model
+	loader  *shared.Loader
+	// key is an index of the selected key of the current secret.
+	key int
+	// keysOffset is the index of the first key line in the info bar.
+	keysOffset int
+	revealed   bool
+	// revealDisabled is set by config to never show secret values.
+	revealDisabled bool
+}
+
+func New(app *shared.App, repo secretsRepo, revealDisabled bool) (*Model, error) {
+	m := Model{
+		repo:           repo,
+		app:            app,
+		infobar:        infobar.New(),
+		loader:         shared.NewLoader(app.Styles.NamespaceSign),
+		revealDisabled: revealDisabled,
+	}
+
+	itemsModel := list.New([]list.Item{}, &secret{
+		Styles: app.Styles,
+	}, 0, 0)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
+	itemsModel.SetShowTitle(false)
+	itemsModel.SetShowStatusBar(false)
+	itemsModel.SetShowHelp(false)
+	itemsModel.Paginator.Type = paginator.Dots
+	m.list = itemsModel
+
+	m.setInfoBarHeight()
+
+	return &m, nil
+}
+
+func (m *Model) Init() tea.Cmd {
+	return m.load()
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case listMsg:
+		return m, m.applyList(msg)
+	case shared.ContextChangedMsg:
+		// the list is loaded again on the namespace change which follows the switch
+		shared.ClearList(&m.list, m.loader)
+		m.resetFocus()
+		m.setInfoContent()
+
+		return m, cmd
+	case shared.NamespaceChangedMsg:
+		m.resetFocus()
+
+		return m, m.load()
+	}
+
+	if cmd = m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.app.KeyMap.FocusRight),
+			m.focused == keysInFocus && key.Matches(msg, m.app.KeyMap.Select):
+			m.changeFocusRight()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.FocusLeft):
+			m.changeFocusLeft()
+
+			return m, cmd
+		case m.focused != listInFocus && key.Matches(msg, m.app.KeyMap.RevealSecret):
+			m.toggleReveal()
+
+			return m, cmd
+		case m.focused == keysInFocus && key.Matches(msg, m.app.KeyMap.Up):
+			m.selectKey(m.key - 1)
+
+			return m, cmd
+		case m.focused == keysInFocus && key.Matches(msg, m.app.KeyMap.Down):
+			m.selectKey(m.key + 1)
+
+			return m, cmd
+		}
+	}
+
+	if m.focused == listInFocus {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+	} else {
+		_, cmd = m.infobar.Update(msg)
+	}
+
+	return m, cmd
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+func (m *Model) View() string {
+	m.setInfoBarHeight()
+
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(), m.list, m.loader))
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+
+	s.WriteString(
+		m.app.Styles.InitStyle.Render(
+			lipgloss.JoinHorizontal(
+				lipgloss.Top,
+				m.app.Styles.ListRightBorder.Render(m.list.View()),
+				m.renderInfoBar(),
+			),
+		))
+
+	return s.String()
+}
+
+// load loads secrets of the current namespace in background, the result is applied by `applyList`.
+func (m *Model) load() tea.Cmd {
+	namespace := m.app.CurrentNamespace
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	return tea.Batch(start, func() tea.Msg {
+		secrets, err := m.repo.GetSecrets(context.Background(), namespace)
+
+		return listMsg{generation: generation, secrets: secrets, err: err}
+	})
+}
+
+// applyList shows the loaded secrets. The previous list is kept if they can't be loaded.
+func (m *Model) applyList(msg listMsg) tea.Cmd {
+	// namespace or context has been changed since the list was requested
+	if !m.loader.Done(msg.generation) {
+		return nil
+	}
+
+	if msg.err != nil {
+		return shared.LoadError(shared.SecretsTab, msg.err)
+	}
+
+	items := make([]list.Item, len(msg.secrets))
+	for i := range msg.secrets {
+		items[i] = newSecret(msg.secrets[i])
+	}
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	m.setInfoContent()
+
+	return nil
+}
+
+func (m *Model) changeFocusRight() {
+	sec := m.getCurrentSecret()
+	if sec == nil {
+		return
+	}
+
+	switch m.focused {
+	case listInFocus:
+		m.focused = keysInFocus
+		m.key = 0
+	case keysInFocus:
+		if len(sec.Keys) == 0 {
+			return
+		}
+		m.focused = valueInFocus
+		m.revealed = false
+	default:
+		return
+	}
+
+	m.setInfoContent()
+	m.infobar.ResetIndent()
+	m.infobar.ResetView()
+}
+
+func (m *Model) changeFocusLeft() {
+	switch m.focused {
+	case valueInFocus:
+		m.focused = keysInFocus
+		m.revealed = false
+		m.setInfoContent()
+		m.infobar.ResetIndent()
+		m.infobar.ResetView()
+		m.infobar.ShowLine(m.keysOffset + m.key)
+	case keysInFocus:
+		m.focused = listInFocus
+		m.setInfoContent()
+		m.infobar.ResetIndent()
+		m.infobar.ResetView()
+	}
+}
+
+// toggleReveal shows or hides the value of the selected key. The value is opened if keys are in focus.
+func (m *Model) toggleReveal() {
+	if m.revealDisabled {
+		return
+	}
+
+	if m.focused == keysInFocus {
+		m.changeFocusRight()
+		if m.focused != valueInFocus {
+			return
+		}
+	}
+
+	m.revealed = !m.revealed
+	m.setInfoContent()
+}
+
+// selectKey moves the keys cursor and scrolls the info bar to keep the selected key visible.
+func (m *Model) selectKey(i int) {
+	sec := m.getCurrentSecret()
+	if sec == nil || i < 0 || i >= len(sec.Keys) {
+		return
+	}
+
+	m.key = i
+	m.setInfoContent()
+	m.infobar.ShowLine(m.keysOffset + m.key)
+}
+
+func (m *Model) resetFocus() {
+	m.focused = listInFocus
+	m.key = 0
+	m.revealed = false
+	m.infobar.ResetIndent()
+	m.list.ResetSelected()
+}
+
+func (m *Model) renderInfoBar() string {
+	infoData := m.infobar.View()
+
+	if m.focused == listInFocus {
+		infoData = m.app.Styles.InactiveText.Render(infoData)
+	}
+
+	info := lipgloss.JoinVertical(lipgloss.Left,
+		m.renderInfoBarTabs(),
+		infoData,
+	)
+
+	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
+}
+
+func (m *Model) getCurrentSecret() *secret {
+	item := m.list.SelectedItem()
+	sec, ok := item.(*secret)
+	if !ok {
+		return nil
+	}
+
+	return sec
+}
+
+func (m *Model) renderInfoBarTabs() string {
+	tabs := getInfoTabs()
+	titles := make([]string, len(tabs))
+	for i := range tabs {
+		if m.focused == tabs[i] {
+			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i].String())
+
+			continue
+		}
+		titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i].String())
+	}
+
+	titlesStr := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		titles...,
+	)
+
+	gap := m.app.Styles.InfoGap.Render(
+		strings.Repeat(" ", shared.Max(0, m.app.GUI.ScreenWidth-lipgloss.Width(titlesStr))),
+	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, titlesStr, gap)
+}
+
+func (m *Model) setInfoContent() {
+	sec := m.getCurrentSecret()
+	if sec == nil {
+		m.infobar.SetContent("")
+
+		return
+	}
+	sec.Styles = m.app.Styles
+
+	var info string
+	switch m.focused {
+	case listInFocus:
+		info, m.keysOffset = sec.renderInfo(-1)
+	case keysInFocus:
+		info, m.keysOffset = sec.renderInfo(m.key)
+	case valueInFocus:
+		if m.key >= len(sec.Keys) {
+			break
+		}
+		info = sec.renderValue(sec.Keys[m.key], m.revealed)
+		if m.revealDisabled {
+			info += "\n\nReveal is disabled by config"
+		}
+	}
+
+	m.infobar.SetContent(info)
+}
+
+func (m *Model) setInfoBarHeight() {
+	m.infobar.SetWH(
+		m.app.InfoBarWidth(getHeader()),
+		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+	)
+	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
+}
+
+func getInfoTabs() []focused {
+	return []focused{
+		keysInFocus,
+		valueInFocus,
+	}
+}
+
+func (f focused) String() string {
+	switch f {
+	case keysInFocus:
+		return "Keys"
+	case valueInFocus:
+		return "Value"
+	default:
+		return ""
+	}
+}
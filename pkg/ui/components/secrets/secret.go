@@ -0,0 +1,261 @@
+package secrets
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	nameHeader        = "Name"
+	typeHeader        = "Type"
+	keysHeader        = "Keys"
+	ageHeader         = "Age"
+	minColumnGap      = "  "
+	nameColumnLen     = 30
+	typeColumnLen     = 30 // `kubernetes.io/dockerconfigjson` fits
+	keysColumnLen     = len(keysHeader)
+	tableHeaderHeight = 3
+	// hexPreviewLen is the max number of binary value bytes shown in the hex preview.
+	hexPreviewLen     = 512
+	mask              = "********"
+	typeTLS           = "kubernetes.io/tls"
+	typeDockerCfg     = "kubernetes.io/dockercfg"
+	typeDockerCfgJSON = "kubernetes.io/dockerconfigjson"
+)
+
+// nolint gochecknoglobals: used here on purpose
+var boldText = lipgloss.NewStyle().Bold(true)
+
+type (
+	secret struct {
+		Name         string
+		Type         string
+		Keys         []string
+		Data         map[string][]byte
+		Labels       map[string]string
+		Age          string
+		Created      time.Time
+		Certificates []domain.Certificate
+		Registries   []string
+		Styles       *themes.Styles
+	}
+)
+
+func newSecret(s domain.Secret) *secret {
+	keys := make([]string, 0, len(s.Data))
+	for k := range s.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return &secret{
+		Name:         s.Name,
+		Type:         s.Type,
+		Keys:         keys,
+		Data:         s.Data,
+		Labels:       s.Labels,
+		Age:          s.Age,
+		Created:      s.Created,
+		Certificates: s.Certificates,
+		Registries:   s.Registries,
+	}
+}
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+func (s *secret) FilterValue() string { return shared.FilterValueWithLabels(s.Name, s.Labels) }
+func (s *secret) Height() int         { return 1 }
+func (s *secret) Spacing() int        { return 1 }
+func (s *secret) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (s *secret) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	sec, ok := listItem.(*secret)
+	if !ok {
+		return
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(sec.Name, nameColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(sec.Type, typeColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(strconv.Itoa(len(sec.Keys)), keysColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(sec.Age)
+
+	rowString := row.String()
+
+	if index == m.Index() {
+		fmt.Fprint(w, s.Styles.SelectedText.Render(rowString))
+	} else {
+		fmt.Fprint(w, s.Styles.MainText.Render(rowString))
+	}
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(nameHeader)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(typeHeader)
+	header.WriteString(strings.Repeat(" ", typeColumnLen-len(typeHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(keysHeader)
+	header.WriteString(strings.Repeat(" ", keysColumnLen-len(keysHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(ageHeader)
+
+	return header.String()
+}
+
+// renderInfo renders the secret type, type specific details and the keys with their sizes. Values are never rendered.
+// Selected key is highlighted if selected is not negative.
+// It returns the index of the first key line as well to let the caller keep the selected key visible.
+func (s *secret) renderInfo(selected int) (string, int) {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Type"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.Type)
+	info.WriteString("\n")
+
+	switch s.Type {
+	case typeTLS:
+		info.WriteString(s.renderCertificates())
+	case typeDockerCfg, typeDockerCfgJSON:
+		info.WriteString(s.renderRegistries())
+	}
+
+	info.WriteString(boldText.Render("Keys"))
+	info.WriteString("\n")
+	keysOffset := strings.Count(info.String(), "\n")
+
+	if len(s.Keys) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString("<none>")
+
+		return info.String(), keysOffset
+	}
+
+	for i, k := range s.Keys {
+		line := fmt.Sprintf("%s%s%s(%d bytes)", minColumnGap, k, minColumnGap, len(s.Data[k]))
+		if i == selected {
+			line = s.Styles.SelectedText.Render(line)
+		}
+		info.WriteString(line)
+		info.WriteString("\n")
+	}
+
+	return info.String(), keysOffset
+}
+
+func (s *secret) renderCertificates() string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Certificates"))
+	info.WriteString("\n")
+
+	if len(s.Certificates) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString("couldn't parse certificate")
+		info.WriteString("\n")
+
+		return info.String()
+	}
+
+	for i := range s.Certificates {
+		cert := s.Certificates[i]
+		info.WriteString(minColumnGap)
+		info.WriteString("Subject: ")
+		info.WriteString(cert.Subject)
+		info.WriteString("\n")
+		info.WriteString(minColumnGap)
+		info.WriteString("Issuer: ")
+		info.WriteString(cert.Issuer)
+		info.WriteString("\n")
+		if sans := append(append([]string{}, cert.DNSNames...), cert.IPs...); len(sans) > 0 {
+			info.WriteString(minColumnGap)
+			info.WriteString("SANs: ")
+			info.WriteString(strings.Join(sans, ", "))
+			info.WriteString("\n")
+		}
+		info.WriteString(minColumnGap)
+		info.WriteString("Valid: ")
+		info.WriteString(cert.NotBefore.Format(shared.TimeFormat))
+		info.WriteString(" - ")
+		info.WriteString(cert.NotAfter.Format(shared.TimeFormat))
+		if time.Now().After(cert.NotAfter) {
+			info.WriteString(" ")
+			info.WriteString(boldText.Render("(expired)"))
+		}
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
+
+func (s *secret) renderRegistries() string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Registries"))
+	info.WriteString("\n")
+
+	if len(s.Registries) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString("<none>")
+		info.WriteString("\n")
+	}
+
+	for _, r := range s.Registries {
+		info.WriteString(minColumnGap)
+		info.WriteString(r)
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
+
+// renderValue renders the value of the key. Not revealed value is masked.
+func (s *secret) renderValue(k string, revealed bool) string {
+	v := s.Data[k]
+
+	if !revealed {
+		return fmt.Sprintf("%s (%d bytes)", mask, len(v))
+	}
+
+	if utf8.Valid(v) {
+		return string(v)
+	}
+
+	var value strings.Builder
+	value.WriteString(boldText.Render(fmt.Sprintf("Binary data, %d bytes", len(v))))
+	value.WriteString("\n\n")
+	if len(v) > hexPreviewLen {
+		value.WriteString(hex.Dump(v[:hexPreviewLen]))
+		value.WriteString("...")
+	} else {
+		value.WriteString(hex.Dump(v))
+	}
+
+	return value.String()
+}
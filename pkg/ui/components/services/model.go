@@ -0,0 +1,400 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/prompt"
+)
+
+type focused int
+
+const (
+	listInFocus focused = iota
+	infoInFocus
+)
+
+type servicesRepo interface {
+	GetServices(ctx context.Context, namespace string) ([]domain.Service, error)
+	GetPodsBySelector(ctx context.Context, namespace string, selector map[string]string) ([]domain.Pod, error)
+	StartPortForward(ctx context.Context, target domain.PortForwardTarget, localPort int) (domain.PortForward, error)
+}
+
+// listMsg is a result of the services loading.
+type listMsg struct {
+	generation int
+	services   []domain.Service
+	err        error
+}
+
+// podsMsg keeps pods resolved by the service selector.
+type podsMsg struct {
+	namespace string
+	service   string
+	pods      []domain.Pod
+	err       error
+}
+
+// Model for services.
+type Model struct {
+	app     *shared.App
+	list    list.Model
+	repo    servicesRepo
+	focused focused
+	infobar *infobar.Model
+	loader  *shared.Loader
+	prompt  *prompt.Model
+	events  chan tea.Msg
+	// podsService is the name of the service which backing pods are shown or being resolved.
+	podsService string
+	pods        *podsMsg
+	// status is the result of the last action.
+	status *actionMsg
+}
+
+func New(app *shared.App, repo servicesRepo) (*Model, error) {
+	m := Model{
+		repo:    repo,
+		app:     app,
+		infobar: infobar.New(),
+		loader:  shared.NewLoader(app.Styles.NamespaceSign),
+		prompt:  prompt.New(),
+		events:  make(chan tea.Msg),
+	}
+
+	itemsModel := list.New([]list.Item{}, &service{
+		Styles: app.Styles,
+	}, 0, 0)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
+	itemsModel.SetShowTitle(false)
+	itemsModel.SetShowStatusBar(false)
+	itemsModel.SetShowHelp(false)
+	itemsModel.Paginator.Type = paginator.Dots
+	m.list = itemsModel
+
+	m.setInfoBarHeight()
+
+	return &m, nil
+}
+
+func (m *Model) Init() tea.Cmd {
+	return tea.Batch(m.waitForEvent(), m.load())
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case listMsg:
+		return m, m.applyList(msg)
+	case shared.ContextChangedMsg:
+		// the list is loaded again on the namespace change which follows the switch
+		shared.ClearList(&m.list, m.loader)
+		m.resetFocus()
+		m.setInfoContent()
+
+		return m, cmd
+	case shared.NamespaceChangedMsg:
+		m.resetFocus()
+
+		return m, m.load()
+	case podsMsg:
+		if msg.namespace == m.app.CurrentNamespace && msg.service == m.podsService {
+			m.pods = &msg
+			m.setInfoContent()
+		}
+
+		return m, m.waitForEvent()
+	case actionMsg:
+		m.status = &msg
+		m.setInfoContent()
+
+		return m, cmd
+	case forwardMsg:
+		m.askLocalPort(msg.target)
+
+		return m, cmd
+	}
+
+	if cmd = m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	if m.prompt.Open() {
+		_, cmd = m.prompt.Update(msg)
+
+		return m, cmd
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.app.KeyMap.FocusRight):
+			m.changeFocusRight()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.FocusLeft):
+			m.changeFocusLeft()
+			m.infobar.ResetView()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.PortForward):
+			m.askPortForward()
+
+			return m, cmd
+		}
+	}
+
+	if m.listInFocus() {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+	} else {
+		_, cmd = m.infobar.Update(msg)
+	}
+
+	return m, cmd
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+// DialogOpen returns true if the port forward prompt waits for the answer.
+func (m *Model) DialogOpen() bool {
+	return m.prompt.Open()
+}
+
+func (m *Model) View() string {
+	m.setInfoBarHeight()
+
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(), m.list, m.loader))
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+
+	s.WriteString(
+		m.app.Styles.InitStyle.Render(
+			lipgloss.JoinHorizontal(
+				lipgloss.Top,
+				m.app.Styles.ListRightBorder.Render(m.list.View()),
+				m.renderInfoBar(),
+			),
+		))
+
+	return s.String()
+}
+
+// load loads services of the current namespace in background, the result is applied by `applyList`.
+func (m *Model) load() tea.Cmd {
+	namespace := m.app.CurrentNamespace
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	return tea.Batch(start, func() tea.Msg {
+		svcs, err := m.repo.GetServices(context.Background(), namespace)
+
+		return listMsg{generation: generation, services: svcs, err: err}
+	})
+}
+
+// applyList shows the loaded services. The previous list is kept if they can't be loaded.
+func (m *Model) applyList(msg listMsg) tea.Cmd {
+	// namespace or context has been changed since the list was requested
+	if !m.loader.Done(msg.generation) {
+		return nil
+	}
+
+	if msg.err != nil {
+		return shared.LoadError(shared.ServicesTab, msg.err)
+	}
+
+	// backing pods must be resolved again for the new list
+	m.podsService = ""
+
+	items := make([]list.Item, len(msg.services))
+	for i := range msg.services {
+		items[i] = newService(msg.services[i])
+	}
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	m.setInfoContent()
+
+	return nil
+}
+
+func (m *Model) waitForEvent() tea.Cmd {
+	return func() tea.Msg {
+		return <-m.events
+	}
+}
+
+// resolvePods requests pods matching the service selector in background.
+// The result is sent to the model events channel which is read by `waitForEvent` command.
+func (m *Model) resolvePods(svc *service) {
+	namespace := m.app.CurrentNamespace
+	name := svc.Name
+	selector := svc.Selector
+
+	go func() {
+		pods, err := m.repo.GetPodsBySelector(context.Background(), namespace, selector)
+		m.events <- podsMsg{
+			namespace: namespace,
+			service:   name,
+			pods:      pods,
+			err:       err,
+		}
+	}()
+}
+
+func (m *Model) changeFocusRight() {
+	if m.listInFocus() {
+		m.focused = infoInFocus
+	}
+}
+
+func (m *Model) changeFocusLeft() {
+	if m.infoInFocus() {
+		m.focused = listInFocus
+	}
+}
+
+func (m *Model) listInFocus() bool {
+	return m.focused == listInFocus
+}
+
+func (m *Model) infoInFocus() bool {
+	return m.focused == infoInFocus
+}
+
+func (m *Model) resetFocus() {
+	m.status = nil
+	m.prompt.Close()
+	m.focused = listInFocus
+	m.infobar.ResetIndent()
+	m.list.ResetSelected()
+}
+
+func (m *Model) renderInfoBar() string {
+	if m.prompt.Open() {
+		return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(
+			m.prompt.View(
+				m.app.InfoBarWidth(getHeader()),
+				m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+				m.app.Styles.MainText,
+			),
+		)
+	}
+
+	infoData := m.infobar.View()
+
+	if !m.infoInFocus() {
+		infoData = m.app.Styles.InactiveText.Render(infoData)
+	}
+
+	info := lipgloss.JoinVertical(lipgloss.Left,
+		m.renderInfoBarTabs(),
+		infoData,
+	)
+
+	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
+}
+
+func (m *Model) getCurrentService() *service {
+	item := m.list.SelectedItem()
+	svc, ok := item.(*service)
+	if !ok {
+		return nil
+	}
+
+	return svc
+}
+
+func (m *Model) renderInfoBarTabs() string {
+	tabs := getInfoTabs()
+	titles := make([]string, len(tabs))
+	for i := range tabs {
+		if m.infoInFocus() {
+			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i])
+		} else {
+			titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i])
+		}
+	}
+
+	titlesStr := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		titles...,
+	)
+
+	gap := m.app.Styles.InfoGap.Render(
+		strings.Repeat(" ", shared.Max(0, m.app.GUI.ScreenWidth-lipgloss.Width(titlesStr))),
+	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, titlesStr, gap)
+}
+
+func (m *Model) setInfoContent() {
+	svc := m.getCurrentService()
+	if svc == nil {
+		m.infobar.SetContent(m.renderStatus())
+
+		return
+	}
+	svc.Styles = m.app.Styles
+
+	if svc.Name != m.podsService {
+		m.podsService = svc.Name
+		m.pods = nil
+		m.resolvePods(svc)
+	}
+
+	var info strings.Builder
+	info.WriteString(m.renderStatus())
+	info.WriteString(svc.renderInfo())
+	switch {
+	case m.pods == nil:
+		info.WriteString(boldText.Render("Backing pods"))
+		info.WriteString("\n")
+		info.WriteString(minColumnGap)
+		info.WriteString("Loading...")
+	case m.pods.err != nil:
+		info.WriteString(boldText.Render("Backing pods"))
+		info.WriteString("\n")
+		info.WriteString(minColumnGap)
+		info.WriteString(m.pods.err.Error())
+	default:
+		info.WriteString(svc.renderBackingPods(m.pods.pods))
+	}
+
+	m.infobar.SetContent(info.String())
+}
+
+func (m *Model) setInfoBarHeight() {
+	m.infobar.SetWH(
+		m.app.InfoBarWidth(getHeader()),
+		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+	)
+	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
+}
+
+func getInfoTabs() []string {
+	return []string{"Info"}
+}
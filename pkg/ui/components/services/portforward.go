@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+// actionMsg is a result of the action with a service.
+type actionMsg struct {
+	text string
+	err  error
+}
+
+// forwardMsg is sent when user has chosen the service port, the local port is asked next.
+// The prompt can't be reopened from its own action, it's closed right after the action.
+type forwardMsg struct {
+	target domain.PortForwardTarget
+}
+
+// askPortForward asks for the service port to forward to. It's not asked if the service has the only port.
+// The traffic goes to a running pod of the service like `kubectl port-forward svc/name` does.
+func (m *Model) askPortForward() {
+	svc := m.getCurrentService()
+	if svc == nil {
+		return
+	}
+
+	target := domain.PortForwardTarget{
+		Kind:      domain.PortForwardTargetService,
+		Namespace: m.app.CurrentNamespace,
+		Name:      svc.Name,
+	}
+
+	if len(svc.Ports) == 1 {
+		target.Port = svc.Ports[0].Port
+		m.askLocalPort(target)
+
+		return
+	}
+
+	var initial int
+	if len(svc.Ports) > 0 {
+		initial = svc.Ports[0].Port
+	}
+	m.prompt.Ask(fmt.Sprintf("Forward to port of service %s:", svc.Name), initial, func(port int) tea.Cmd {
+		target.Port = port
+
+		return func() tea.Msg {
+			return forwardMsg{target: target}
+		}
+	})
+}
+
+// askLocalPort asks for the local port and starts the forward. The forward keeps running in the background,
+// it's managed in the port forwards tab.
+func (m *Model) askLocalPort(target domain.PortForwardTarget) {
+	question := fmt.Sprintf("Forward local port to %s (0 picks a free port):", target)
+	m.prompt.Ask(question, target.Port, func(localPort int) tea.Cmd {
+		return func() tea.Msg {
+			if _, err := m.repo.StartPortForward(context.Background(), target, localPort); err != nil {
+				return actionMsg{err: err}
+			}
+
+			return actionMsg{text: fmt.Sprintf("port forward to %s started", target)}
+		}
+	})
+}
+
+func (m *Model) renderStatus() string {
+	switch {
+	case m.status == nil:
+		return ""
+	case m.status.err != nil:
+		return m.app.Styles.SelectedText.Render("Error: "+m.status.err.Error()) + "\n"
+	default:
+		return m.app.Styles.NamespaceSign.Render(m.status.text) + "\n"
+	}
+}
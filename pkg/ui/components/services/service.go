@@ -0,0 +1,260 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	nameHeader         = "Name"
+	typeHeader         = "Type"
+	clusterIPHeader    = "ClusterIP"
+	portsHeader        = "Ports"
+	ageHeader          = "Age"
+	minColumnGap       = "  "
+	nameColumnLen      = 20
+	typeColumnLen      = 12 // the longest type `LoadBalancer`
+	clusterIPColumnLen = 15
+	portsColumnLen     = 15
+	tableHeaderHeight  = 3
+	noneValue          = "<none>"
+)
+
+// nolint gochecknoglobals: used here on purpose
+var boldText = lipgloss.NewStyle().Bold(true)
+
+type (
+	service struct {
+		Name        string
+		Type        string
+		ClusterIP   string
+		ExternalIPs []string
+		Ports       []domain.ServicePort
+		Selector    map[string]string
+		Labels      map[string]string
+		Age         string
+		Created     time.Time
+		Styles      *themes.Styles
+	}
+)
+
+func newService(s domain.Service) *service {
+	return &service{
+		Name:        s.Name,
+		Type:        s.Type,
+		ClusterIP:   s.ClusterIP,
+		ExternalIPs: s.ExternalIPs,
+		Ports:       s.Ports,
+		Selector:    s.Selector,
+		Labels:      s.Labels,
+		Age:         s.Age,
+		Created:     s.Created,
+	}
+}
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+func (s *service) FilterValue() string { return shared.FilterValueWithLabels(s.Name, s.Labels) }
+func (s *service) Height() int         { return 1 }
+func (s *service) Spacing() int        { return 1 }
+func (s *service) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (s *service) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	svc, ok := listItem.(*service)
+	if !ok {
+		return
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(svc.Name, nameColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(svc.Type, typeColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(svc.ClusterIP, clusterIPColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(svc.shortPorts(), portsColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(svc.Age)
+
+	rowString := row.String()
+
+	if index == m.Index() {
+		fmt.Fprint(w, s.Styles.SelectedText.Render(rowString))
+	} else {
+		fmt.Fprint(w, s.Styles.MainText.Render(rowString))
+	}
+}
+
+// shortPorts returns ports in kubectl way: `80/TCP,443:30443/TCP`.
+func (s *service) shortPorts() string {
+	if len(s.Ports) == 0 {
+		return noneValue
+	}
+
+	ports := make([]string, len(s.Ports))
+	for i := range s.Ports {
+		if s.Ports[i].NodePort != 0 {
+			ports[i] = fmt.Sprintf("%d:%d/%s", s.Ports[i].Port, s.Ports[i].NodePort, s.Ports[i].Protocol)
+
+			continue
+		}
+		ports[i] = fmt.Sprintf("%d/%s", s.Ports[i].Port, s.Ports[i].Protocol)
+	}
+
+	return strings.Join(ports, ",")
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(nameHeader)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(typeHeader)
+	header.WriteString(strings.Repeat(" ", typeColumnLen-len(typeHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(clusterIPHeader)
+	header.WriteString(strings.Repeat(" ", clusterIPColumnLen-len(clusterIPHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(portsHeader)
+	header.WriteString(strings.Repeat(" ", portsColumnLen-len(portsHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(ageHeader)
+
+	return header.String()
+}
+
+func (s *service) renderInfo() string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Name"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.Name)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Created"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.Created.Format(shared.TimeFormat))
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("Type"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.Type)
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("Cluster IP"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.ClusterIP)
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("External IPs"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	if len(s.ExternalIPs) == 0 {
+		info.WriteString(noneValue)
+	} else {
+		info.WriteString(strings.Join(s.ExternalIPs, ", "))
+	}
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("Ports"))
+	info.WriteString("\n")
+	for i := range s.Ports {
+		info.WriteString(minColumnGap)
+		if s.Ports[i].Name != "" {
+			info.WriteString(s.Ports[i].Name)
+			info.WriteString(": ")
+		}
+		info.WriteString(fmt.Sprintf("%d/%s -> %s", s.Ports[i].Port, s.Ports[i].Protocol, s.Ports[i].TargetPort))
+		if s.Ports[i].NodePort != 0 {
+			info.WriteString(fmt.Sprintf(" (node port %d)", s.Ports[i].NodePort))
+		}
+		info.WriteString("\n")
+	}
+
+	info.WriteString(boldText.Render("Selector"))
+	info.WriteString("\n")
+	if len(s.Selector) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString(noneValue)
+		info.WriteString("\n")
+	}
+	for k, v := range s.Selector {
+		info.WriteString(minColumnGap)
+		info.WriteString(k)
+		info.WriteString("=")
+		info.WriteString(v)
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
+
+// renderBackingPods renders pods matched by service selector with their readiness.
+func (s *service) renderBackingPods(pods []domain.Pod) string {
+	var info strings.Builder
+
+	info.WriteString(boldText.Render("Backing pods"))
+	info.WriteString("\n")
+
+	if len(s.Selector) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString("Service has no selector, endpoints are managed manually")
+		info.WriteString("\n")
+
+		return info.String()
+	}
+
+	if len(pods) == 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString(s.Styles.NamespaceSign.Render("No pods match the selector: service has no endpoints"))
+		info.WriteString("\n")
+
+		return info.String()
+	}
+
+	var ready int
+	for i := range pods {
+		if pods[i].StatusInfo.Ready {
+			ready++
+		}
+	}
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Ready: %d/%d", ready, len(pods)))
+	info.WriteString("\n")
+
+	for i := range pods {
+		info.WriteString(minColumnGap)
+		info.WriteString(shared.GetTextWithLen(pods[i].Name, nameColumnLen))
+		info.WriteString(minColumnGap)
+		info.WriteString(pods[i].Ready)
+		info.WriteString(minColumnGap)
+		info.WriteString(pods[i].Status)
+		info.WriteString(minColumnGap)
+		info.WriteString(pods[i].StatusInfo.PodIP)
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
@@ -0,0 +1,362 @@
+package statefulsets
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/divider"
+	"github.com/tty2/kubic/pkg/ui/shared/elements/infobar"
+)
+
+type focused int
+
+const (
+	listInFocus focused = iota
+	infoInFocus
+)
+
+type statefulSetsRepo interface {
+	GetStatefulSets(ctx context.Context, namespace string) ([]domain.StatefulSet, error)
+	GetPodsBySelector(ctx context.Context, namespace string, selector map[string]string) ([]domain.Pod, error)
+}
+
+// listMsg is a result of the stateful sets loading.
+type listMsg struct {
+	generation   int
+	statefulSets []domain.StatefulSet
+	err          error
+}
+
+// podsMsg keeps pods of the stateful set resolved by its selector.
+type podsMsg struct {
+	namespace   string
+	statefulSet string
+	pods        []domain.Pod
+	err         error
+}
+
+// Model for stateful sets.
+type Model struct {
+	app     *shared.App
+	list    list.Model
+	repo    statefulSetsRepo
+	focused focused
+	infobar *infobar.Model
+	loader  *shared.Loader
+	events  chan tea.Msg
+	// podsOwner is the name of the stateful set which pods are shown or being resolved.
+	podsOwner string
+	pods      *podsMsg
+}
+
+func New(app *shared.App, repo statefulSetsRepo) (*Model, error) {
+	m := Model{
+		repo:    repo,
+		app:     app,
+		infobar: infobar.New(),
+		loader:  shared.NewLoader(app.Styles.NamespaceSign),
+		events:  make(chan tea.Msg),
+	}
+
+	itemsModel := list.New([]list.Item{}, &statefulSet{
+		Styles: app.Styles,
+	}, 0, 0)
+	shared.SetupFiltering(&itemsModel, app.Styles.SelectedText)
+	itemsModel.SetShowTitle(false)
+	itemsModel.SetShowStatusBar(false)
+	itemsModel.SetShowHelp(false)
+	itemsModel.Paginator.Type = paginator.Dots
+	m.list = itemsModel
+
+	m.setInfoBarHeight()
+
+	return &m, nil
+}
+
+func (m *Model) Init() tea.Cmd {
+	return tea.Batch(m.waitForEvent(), m.load())
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case listMsg:
+		return m, m.applyList(msg)
+	case shared.ContextChangedMsg:
+		// the list is loaded again on the namespace change which follows the switch
+		shared.ClearList(&m.list, m.loader)
+		m.resetFocus()
+		m.setInfoContent()
+
+		return m, cmd
+	case shared.NamespaceChangedMsg:
+		m.resetFocus()
+
+		return m, m.load()
+	}
+
+	if cmd = m.loader.Update(msg); cmd != nil {
+		return m, cmd
+	}
+
+	if msg, ok := msg.(podsMsg); ok {
+		if msg.namespace == m.app.CurrentNamespace && msg.statefulSet == m.podsOwner {
+			m.pods = &msg
+			m.setInfoContent()
+		}
+
+		return m, m.waitForEvent()
+	}
+
+	// filter matches and keys typed to the filter always belong to the list
+	if _, ok := msg.(list.FilterMatchesMsg); ok || m.list.FilterState() == list.Filtering {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.app.KeyMap.FocusRight):
+			m.changeFocusRight()
+
+			return m, cmd
+		case key.Matches(msg, m.app.KeyMap.FocusLeft):
+			m.changeFocusLeft()
+			m.infobar.ResetView()
+
+			return m, cmd
+		}
+	}
+
+	if m.listInFocus() {
+		m.list, cmd = m.list.Update(msg)
+		m.setInfoContent()
+	} else {
+		_, cmd = m.infobar.Update(msg)
+	}
+
+	return m, cmd
+}
+
+// FilterState returns the state of the list filter.
+func (m *Model) FilterState() list.FilterState {
+	return m.list.FilterState()
+}
+
+func (m *Model) View() string {
+	m.setInfoBarHeight()
+
+	var s strings.Builder
+	s.WriteString("\n")
+	s.WriteString(shared.RenderTableHeader(m.app, getHeader(), m.list, m.loader))
+	s.WriteString("\n")
+	s.WriteString(divider.HorizontalLine(m.app.GUI.ScreenWidth, m.app.Styles.InactiveText))
+	s.WriteString("\n")
+
+	s.WriteString(
+		m.app.Styles.InitStyle.Render(
+			lipgloss.JoinHorizontal(
+				lipgloss.Top,
+				m.app.Styles.ListRightBorder.Render(m.list.View()),
+				m.renderInfoBar(),
+			),
+		))
+
+	return s.String()
+}
+
+// load loads stateful sets of the current namespace in background, the result is applied by `applyList`.
+func (m *Model) load() tea.Cmd {
+	namespace := m.app.CurrentNamespace
+	start := m.loader.Start()
+	generation := m.loader.Generation()
+
+	return tea.Batch(start, func() tea.Msg {
+		sets, err := m.repo.GetStatefulSets(context.Background(), namespace)
+
+		return listMsg{generation: generation, statefulSets: sets, err: err}
+	})
+}
+
+// applyList shows the loaded stateful sets. The previous list is kept if they can't be loaded.
+func (m *Model) applyList(msg listMsg) tea.Cmd {
+	// namespace or context has been changed since the list was requested
+	if !m.loader.Done(msg.generation) {
+		return nil
+	}
+
+	if msg.err != nil {
+		return shared.LoadError(shared.StatefulSetsTab, msg.err)
+	}
+
+	// pods must be resolved again for the new list
+	m.podsOwner = ""
+
+	items := make([]list.Item, len(msg.statefulSets))
+	for i := range msg.statefulSets {
+		items[i] = newStatefulSet(msg.statefulSets[i])
+	}
+
+	shared.ApplyFilter(&m.list, m.list.SetItems(items))
+	m.setInfoContent()
+
+	return nil
+}
+
+func (m *Model) waitForEvent() tea.Cmd {
+	return func() tea.Msg {
+		return <-m.events
+	}
+}
+
+// resolvePods requests pods matching the stateful set selector in background.
+// The result is sent to the model events channel which is read by `waitForEvent` command.
+func (m *Model) resolvePods(sts *statefulSet) {
+	namespace := m.app.CurrentNamespace
+	name := sts.Name
+	selector := sts.Selector
+
+	go func() {
+		pods, err := m.repo.GetPodsBySelector(context.Background(), namespace, selector)
+		m.events <- podsMsg{
+			namespace:   namespace,
+			statefulSet: name,
+			pods:        pods,
+			err:         err,
+		}
+	}()
+}
+
+func (m *Model) changeFocusRight() {
+	if m.listInFocus() {
+		m.focused = infoInFocus
+	}
+}
+
+func (m *Model) changeFocusLeft() {
+	if m.infoInFocus() {
+		m.focused = listInFocus
+	}
+}
+
+func (m *Model) listInFocus() bool {
+	return m.focused == listInFocus
+}
+
+func (m *Model) infoInFocus() bool {
+	return m.focused == infoInFocus
+}
+
+func (m *Model) resetFocus() {
+	m.focused = listInFocus
+	m.infobar.ResetIndent()
+	m.list.ResetSelected()
+}
+
+func (m *Model) renderInfoBar() string {
+	infoData := m.infobar.View()
+
+	if !m.infoInFocus() {
+		infoData = m.app.Styles.InactiveText.Render(infoData)
+	}
+
+	info := lipgloss.JoinVertical(lipgloss.Left,
+		m.renderInfoBarTabs(),
+		infoData,
+	)
+
+	return m.app.Styles.InitStyle.Copy().MarginLeft(m.app.Styles.TextLeftMargin).Render(info)
+}
+
+func (m *Model) getCurrentStatefulSet() *statefulSet {
+	item := m.list.SelectedItem()
+	sts, ok := item.(*statefulSet)
+	if !ok {
+		return nil
+	}
+
+	return sts
+}
+
+func (m *Model) renderInfoBarTabs() string {
+	tabs := getInfoTabs()
+	titles := make([]string, len(tabs))
+	for i := range tabs {
+		if m.infoInFocus() {
+			titles[i] = m.app.Styles.ActiveInfoTab.Render(tabs[i])
+		} else {
+			titles[i] = m.app.Styles.InactiveInfoTab.Render(tabs[i])
+		}
+	}
+
+	titlesStr := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		titles...,
+	)
+
+	gap := m.app.Styles.InfoGap.Render(
+		strings.Repeat(" ", shared.Max(0, m.app.GUI.ScreenWidth-lipgloss.Width(titlesStr))),
+	)
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, titlesStr, gap)
+}
+
+func (m *Model) setInfoContent() {
+	sts := m.getCurrentStatefulSet()
+	if sts == nil {
+		m.infobar.SetContent("")
+
+		return
+	}
+	sts.Styles = m.app.Styles
+
+	if sts.Name != m.podsOwner {
+		m.podsOwner = sts.Name
+		m.pods = nil
+		m.resolvePods(sts)
+	}
+
+	var info strings.Builder
+	info.WriteString(sts.renderInfo())
+	switch {
+	case m.pods == nil:
+		info.WriteString(boldText.Render("Ordinals"))
+		info.WriteString("\n")
+		info.WriteString(minColumnGap)
+		info.WriteString("Loading...")
+		info.WriteString("\n")
+	case m.pods.err != nil:
+		info.WriteString(boldText.Render("Ordinals"))
+		info.WriteString("\n")
+		info.WriteString(minColumnGap)
+		info.WriteString(m.pods.err.Error())
+		info.WriteString("\n")
+	default:
+		info.WriteString(sts.renderOrdinals(m.pods.pods))
+	}
+	info.WriteString(shared.RenderContainersInfo(sts.Containers))
+
+	m.infobar.SetContent(info.String())
+}
+
+func (m *Model) setInfoBarHeight() {
+	m.infobar.SetWH(
+		m.app.InfoBarWidth(getHeader()),
+		m.app.GUI.Areas.MainContent.Height-tableHeaderHeight,
+	)
+	m.list.SetHeight(m.app.GUI.Areas.MainContent.Height - tableHeaderHeight)
+}
+
+func getInfoTabs() []string {
+	return []string{"Info"}
+}
@@ -0,0 +1,225 @@
+package statefulsets
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	nameHeader        = "Name"
+	readyHeader       = "Ready"
+	serviceHeader     = "Service"
+	ageHeader         = "Age"
+	minColumnGap      = "  "
+	nameColumnLen     = 20
+	readyColumnLen    = 7
+	serviceColumnLen  = 20
+	tableHeaderHeight = 3
+)
+
+// nolint gochecknoglobals: used here on purpose
+var boldText = lipgloss.NewStyle().Bold(true)
+
+type (
+	statefulSet struct {
+		Name                string
+		Ready               string
+		Replicas            int
+		ReadyReplicas       int
+		CurrentReplicas     int
+		UpdatedReplicas     int
+		ServiceName         string
+		UpdateStrategy      string
+		Partition           int
+		PodManagementPolicy string
+		Selector            map[string]string
+		Age                 string
+		Labels              map[string]string
+		Created             time.Time
+		Containers          []domain.Container
+		Styles              *themes.Styles
+	}
+)
+
+func newStatefulSet(s domain.StatefulSet) *statefulSet {
+	return &statefulSet{
+		Name:                s.Name,
+		Ready:               s.Ready,
+		Replicas:            s.Replicas,
+		ReadyReplicas:       s.ReadyReplicas,
+		CurrentReplicas:     s.CurrentReplicas,
+		UpdatedReplicas:     s.UpdatedReplicas,
+		ServiceName:         s.ServiceName,
+		UpdateStrategy:      s.UpdateStrategy,
+		Partition:           s.Partition,
+		PodManagementPolicy: s.PodManagementPolicy,
+		Selector:            s.Selector,
+		Age:                 s.Age,
+		Labels:              s.Labels,
+		Created:             s.Created,
+		Containers:          s.Containers,
+	}
+}
+
+// FilterValue is used to set filter item and required for `list.Model` interface.
+func (s *statefulSet) FilterValue() string { return shared.FilterValueWithLabels(s.Name, s.Labels) }
+func (s *statefulSet) Height() int         { return 1 }
+func (s *statefulSet) Spacing() int        { return 1 }
+func (s *statefulSet) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (s *statefulSet) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	sts, ok := listItem.(*statefulSet)
+	if !ok {
+		return
+	}
+
+	var row strings.Builder
+	row.WriteString(shared.GetTextWithLen(sts.Name, nameColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(sts.Ready, readyColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(shared.GetTextWithLen(sts.ServiceName, serviceColumnLen))
+	row.WriteString(minColumnGap)
+
+	row.WriteString(sts.Age)
+
+	rowString := row.String()
+
+	if index == m.Index() {
+		fmt.Fprint(w, s.Styles.SelectedText.Render(rowString))
+	} else {
+		fmt.Fprint(w, s.Styles.MainText.Render(rowString))
+	}
+}
+
+func getHeader() string {
+	var header strings.Builder
+	header.WriteString(minColumnGap)
+
+	header.WriteString(nameHeader)
+	header.WriteString(strings.Repeat(" ", nameColumnLen-len(nameHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(readyHeader)
+	header.WriteString(strings.Repeat(" ", readyColumnLen-len(readyHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(serviceHeader)
+	header.WriteString(strings.Repeat(" ", serviceColumnLen-len(serviceHeader)))
+	header.WriteString(minColumnGap)
+
+	header.WriteString(ageHeader)
+
+	return header.String()
+}
+
+func (s *statefulSet) renderInfo() string {
+	var info strings.Builder
+	info.WriteString(boldText.Render("Name"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.Name)
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Created"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.Created.Format(shared.TimeFormat))
+	info.WriteString("\n")
+	info.WriteString(boldText.Render("Labels"))
+	info.WriteString("\n")
+
+	for k, v := range s.Labels {
+		info.WriteString(minColumnGap)
+		info.WriteString(k)
+		info.WriteString(": ")
+		info.WriteString(v)
+		info.WriteString("\n")
+	}
+
+	info.WriteString(boldText.Render("Replicas"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Desired: %d\n", s.Replicas))
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Ready: %d\n", s.ReadyReplicas))
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Current: %d\n", s.CurrentReplicas))
+	info.WriteString(minColumnGap)
+	info.WriteString(fmt.Sprintf("Updated: %d\n", s.UpdatedReplicas))
+
+	info.WriteString(boldText.Render("Update Strategy"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.UpdateStrategy)
+	info.WriteString("\n")
+	if s.Partition > 0 {
+		info.WriteString(minColumnGap)
+		info.WriteString(fmt.Sprintf("Partition: %d (only ordinals >= %d are updated)\n", s.Partition, s.Partition))
+	}
+
+	info.WriteString(boldText.Render("Pod Management Policy"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.PodManagementPolicy)
+	info.WriteString("\n")
+
+	info.WriteString(boldText.Render("Service"))
+	info.WriteString("\n")
+	info.WriteString(minColumnGap)
+	info.WriteString(s.ServiceName)
+	info.WriteString("\n")
+
+	return info.String()
+}
+
+// renderOrdinals renders readiness of every pod ordinal of the stateful set.
+// Pods are named `<name>-<ordinal>`, so missing ordinals are visible as well.
+func (s *statefulSet) renderOrdinals(pods []domain.Pod) string {
+	byName := make(map[string]domain.Pod, len(pods))
+	for i := range pods {
+		byName[pods[i].Name] = pods[i]
+	}
+
+	var info strings.Builder
+	info.WriteString(boldText.Render("Ordinals"))
+	info.WriteString("\n")
+
+	for i := 0; i < s.Replicas; i++ {
+		name := fmt.Sprintf("%s-%d", s.Name, i)
+
+		info.WriteString(minColumnGap)
+		info.WriteString(shared.GetTextWithLen(name, nameColumnLen))
+		info.WriteString(minColumnGap)
+
+		p, ok := byName[name]
+		switch {
+		case !ok:
+			info.WriteString("Missing")
+		case p.StatusInfo.Ready:
+			info.WriteString("Ready")
+		default:
+			info.WriteString(fmt.Sprintf("Not ready (%s)", p.Status))
+		}
+
+		if s.Partition > 0 && i >= s.Partition {
+			info.WriteString(minColumnGap)
+			info.WriteString("partition")
+		}
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
@@ -11,6 +11,8 @@ import (
 
 const (
 	tabsLeftRightIndents = 2
+	// hiddenTabsSign is shown instead of tabs which don't fit the screen.
+	hiddenTabsSign = " … "
 )
 
 type Model struct {
@@ -32,22 +34,25 @@ func (m *Model) Init() tea.Cmd {
 }
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	if msg, ok := msg.(tea.KeyMsg); ok {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
 		if key.Matches(msg, m.app.KeyMap.Tab) {
 			m.next()
 		} else if key.Matches(msg, m.app.KeyMap.ShiftTab) {
 			m.prev()
 		}
+	case shared.SelectTabMsg:
+		m.selectTab(msg.Tab)
 	}
 
 	return m, nil
 }
 
 func (m *Model) View() string {
-	titles := m.getTabsTitles()
+	titles := m.getVisibleTitles(m.app.GUI.ScreenWidth - tabsLeftRightIndents)
 
 	row := lipgloss.JoinHorizontal(
-		lipgloss.Top,
+		lipgloss.Bottom,
 		titles...,
 	)
 
@@ -68,6 +73,17 @@ func (m *Model) prev() {
 	m.app.CurrentTab = m.tabs[i]
 }
 
+// selectTab opens the tab if it's one of the tabs.
+func (m *Model) selectTab(tab shared.TabItem) {
+	for i := range m.tabs {
+		if m.tabs[i] == tab {
+			m.app.CurrentTab = tab
+
+			return
+		}
+	}
+}
+
 func (m *Model) getNextTabIndex() int {
 	return (m.getCurrentTabIndex() + 1) % len(m.tabs)
 }
@@ -99,3 +115,59 @@ func (m *Model) getTabsTitles() []string {
 
 	return titles
 }
+
+// getVisibleTitles returns titles of tabs which fit the width.
+// If all the tabs don't fit, it returns the window of tabs around the current one,
+// hidden tabs are replaced with the sign on the corresponding side.
+func (m *Model) getVisibleTitles(width int) []string {
+	titles := m.getTabsTitles()
+
+	total := 0
+	for i := range titles {
+		total += lipgloss.Width(titles[i])
+	}
+	if total <= width {
+		return titles
+	}
+
+	sign := m.app.Styles.TabsGap.Render(hiddenTabsSign)
+	signWidth := lipgloss.Width(sign)
+
+	from := m.getCurrentTabIndex()
+	to := from
+	used := lipgloss.Width(titles[from])
+	fits := func(w, from, to int) bool {
+		signs := 0
+		if from > 0 {
+			signs += signWidth
+		}
+		if to < len(titles)-1 {
+			signs += signWidth
+		}
+
+		return used+w+signs <= width
+	}
+
+	// extend the window to the right first: the next tabs are more likely to be opened
+	for {
+		switch {
+		case to < len(titles)-1 && fits(lipgloss.Width(titles[to+1]), from, to+1):
+			to++
+			used += lipgloss.Width(titles[to])
+		case from > 0 && fits(lipgloss.Width(titles[from-1]), from-1, to):
+			from--
+			used += lipgloss.Width(titles[from])
+		default:
+			visible := make([]string, 0, to-from+3) // nolint gomnd: window and two signs
+			if from > 0 {
+				visible = append(visible, sign)
+			}
+			visible = append(visible, titles[from:to+1]...)
+			if to < len(titles)-1 {
+				visible = append(visible, sign)
+			}
+
+			return visible
+		}
+	}
+}
@@ -1,16 +1,23 @@
 package shared
 
 import (
+	"github.com/charmbracelet/lipgloss"
 	"github.com/tty2/kubic/pkg/ui/shared/themes"
 )
 
+// AllNamespacesTitle is the name of the namespaces list entry which shows pods and deployments of all namespaces.
+const AllNamespacesTitle = "all namespaces"
+
 type App struct {
-	CurrentNamespace  string
-	CurrentTab        TabItem
-	Styles            *themes.Styles
-	KeyMap            *KeyMap
-	GUI               GUI
-	updateNScallbacks []func()
+	CurrentContext   string
+	CurrentNamespace string
+	// AllNamespaces is set when pods and deployments are listed in all namespaces.
+	// CurrentNamespace keeps the last chosen namespace for the rest of resources then.
+	AllNamespaces bool
+	CurrentTab    TabItem
+	Styles        *themes.Styles
+	KeyMap        *KeyMap
+	GUI           GUI
 }
 
 type GUI struct {
@@ -34,15 +41,19 @@ func NewApp(theme themes.Theme) *App {
 
 func (app *App) ResizeAreas() {
 	app.GUI.Areas.MainContent.Height = app.GUI.ScreenHeight -
-		(app.GUI.Areas.TabBar.Height + app.GUI.Areas.HelpBar.Height)
+		(app.GUI.Areas.TabBar.Height + app.GUI.Areas.Banner.Height + app.GUI.Areas.HelpBar.Height)
 }
 
-func (app *App) AddUpdateNamespaceCallback(fn func()) {
-	app.updateNScallbacks = append(app.updateNScallbacks, fn)
+// InfoBarWidth returns the width of the info bar content next to the list with the header.
+func (app *App) InfoBarWidth(listHeader string) int {
+	return Max(0, app.GUI.ScreenWidth-lipgloss.Width(listHeader)-ListToInfoContentGap)
 }
 
-func (app *App) OnUpdateNamespace() {
-	for i := range app.updateNScallbacks {
-		app.updateNScallbacks[i]()
+// WorkloadsNamespace returns the namespace pods and deployments are listed in. Empty namespace means all namespaces.
+func (app *App) WorkloadsNamespace() string {
+	if app.AllNamespaces {
+		return ""
 	}
+
+	return app.CurrentNamespace
 }
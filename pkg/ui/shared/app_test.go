@@ -27,29 +27,52 @@ func Test_ResizeAreas(t *testing.T) {
 		app.ResizeAreas()
 		rq.Equal(app.GUI.ScreenHeight-TabsBarHeight-HelpBarHeight, app.GUI.Areas.MainContent.Height)
 	})
+
+	t.Run("banner", func(t *testing.T) {
+		t.Parallel()
+
+		app := NewApp(themes.Theme{})
+		app.GUI.ScreenHeight = 100
+		app.GUI.Areas.Banner.Height = BannerHeight
+		app.ResizeAreas()
+
+		rq.Equal(app.GUI.ScreenHeight-TabsBarHeight-BannerHeight-HelpBarHeight, app.GUI.Areas.MainContent.Height)
+	})
 }
 
-func Test_UpdateCallbacks(t *testing.T) {
+func Test_InfoBarWidth(t *testing.T) {
 	t.Parallel()
-	rq := require.New(t)
 
 	t.Run("ok", func(t *testing.T) {
 		t.Parallel()
-
-		var counter int
+		rq := require.New(t)
 
 		app := NewApp(themes.Theme{})
+		app.GUI.ScreenWidth = 100
 
-		rq.Equal(0, counter)
+		rq.Equal(100-len("NAME  AGE")-ListToInfoContentGap, app.InfoBarWidth("NAME  AGE"))
+	})
 
-		app.AddUpdateNamespaceCallback(func() {
-			counter++
-		})
+	t.Run("narrow screen", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
 
-		app.OnUpdateNamespace()
-		rq.Equal(1, counter)
-		app.OnUpdateNamespace()
-		app.OnUpdateNamespace()
-		rq.Equal(3, counter)
+		app := NewApp(themes.Theme{})
+		app.GUI.ScreenWidth = 10
+
+		rq.Equal(0, app.InfoBarWidth("NAME  AGE"))
 	})
 }
+
+func Test_WorkloadsNamespace(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	app := NewApp(themes.Theme{})
+	app.CurrentNamespace = "team"
+	rq.Equal("team", app.WorkloadsNamespace())
+
+	app.AllNamespaces = true
+	rq.Equal("", app.WorkloadsNamespace())
+	rq.Equal("team", app.CurrentNamespace)
+}
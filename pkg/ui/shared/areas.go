@@ -3,7 +3,12 @@ package shared
 const (
 	TabsBarHeight = 3
 	HelpBarHeight = 2
-	FullScreen    = -1
+	// BannerHeight is the height of the error banner, it takes no space while there is no error.
+	BannerHeight = 1
+	FullScreen   = -1
+	// ListToInfoContentGap is the gap between the list and the info bar content:
+	// it consists from 3 list right padding + vertical line + left info bar padding.
+	ListToInfoContentGap = 6
 )
 
 type uiArea struct {
@@ -12,6 +17,7 @@ type uiArea struct {
 
 type uiAreas struct {
 	TabBar      uiArea
+	Banner      uiArea
 	MainContent uiArea
 	HelpBar     uiArea
 }
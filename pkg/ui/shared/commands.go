@@ -0,0 +1,220 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Commands which are not resources. They are run by the component of the current tab.
+const (
+	ScaleCommand  = "scale"
+	DeleteCommand = "delete"
+	QuitCommand   = "quit"
+)
+
+// ErrUnknownCommand is returned for the command line which doesn't start with a known command.
+var ErrUnknownCommand = errors.New("unknown command")
+
+// ErrCommandNotAvailable is returned when the command can't be run in the tab.
+var ErrCommandNotAvailable = errors.New("command is not available here")
+
+// Command is the command line typed after `:`, like `ns kube-system` or `scale 3`.
+type Command struct {
+	Name string
+	Args []string
+}
+
+// tabCommand is the resource command which jumps to the tab. The first name is the one shown in completion,
+// the rest are aliases like kubectl has.
+type tabCommand struct {
+	tab   TabItem
+	names []string
+}
+
+func tabCommands() []tabCommand {
+	return []tabCommand{
+		{tab: ContextsTab, names: []string{"contexts", "context", "ctx"}},
+		{tab: NamespacesTab, names: []string{"namespaces", "namespace", "ns"}},
+		{tab: DeploymentsTab, names: []string{"deployments", "deployment", "deploy"}},
+		{tab: StatefulSetsTab, names: []string{"statefulsets", "statefulset", "sts"}},
+		{tab: DaemonSetsTab, names: []string{"daemonsets", "daemonset", "ds"}},
+		{tab: CronJobsTab, names: []string{"cronjobs", "cronjob", "cj"}},
+		{tab: PodsTab, names: []string{"pods", "pod", "po"}},
+		{tab: ServicesTab, names: []string{"services", "service", "svc"}},
+		{tab: ConfigMapsTab, names: []string{"configmaps", "configmap", "cm"}},
+		{tab: SecretsTab, names: []string{"secrets", "secret"}},
+		{tab: EventsTab, names: []string{"events", "event", "ev"}},
+		{tab: NodesTab, names: []string{"nodes", "node", "no"}},
+		{tab: PortForwardsTab, names: []string{"portforwards", "portforward", "pf"}},
+	}
+}
+
+// ParseCommand splits the command line into the command and its arguments.
+// Only the known commands are parsed, `ErrUnknownCommand` is returned otherwise.
+func ParseCommand(line string) (Command, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return Command{}, ErrUnknownCommand
+	}
+
+	cmd := Command{
+		Name: fields[0],
+		Args: fields[1:],
+	}
+	if _, ok := cmd.Tab(); ok {
+		return cmd, nil
+	}
+
+	switch cmd.Name {
+	case ScaleCommand, DeleteCommand, QuitCommand:
+		return cmd, nil
+	default:
+		return Command{}, fmt.Errorf("%w: %s", ErrUnknownCommand, cmd.Name)
+	}
+}
+
+// Tab returns the tab the resource command jumps to.
+func (c Command) Tab() (TabItem, bool) {
+	for _, tc := range tabCommands() {
+		for _, name := range tc.names {
+			if name == c.Name {
+				return tc.tab, true
+			}
+		}
+	}
+
+	return AnyTab, false
+}
+
+// Arg returns the arguments joined back with spaces, names with spaces (like all namespaces entry) are kept.
+func (c Command) Arg() string {
+	return strings.Join(c.Args, " ")
+}
+
+// CompleteCommand completes the command line. The command name is completed from the command names and aliases,
+// the argument of a resource command is completed from the values returned by `args`.
+// It returns the completed line and all the matching candidates, the line is completed up to their common prefix.
+func CompleteCommand(line string, args func(tab TabItem) []string) (string, []string) {
+	fields := strings.Fields(line)
+	typingName := len(fields) == 0 || (len(fields) == 1 && !strings.HasSuffix(line, " "))
+
+	if typingName {
+		var prefix string
+		if len(fields) == 1 {
+			prefix = fields[0]
+		}
+		matches := filterPrefix(commandNamesWithAliases(), prefix)
+		if len(matches) == 1 {
+			return matches[0] + " ", matches
+		}
+
+		return commonPrefix(matches, prefix), matches
+	}
+
+	tab, ok := Command{Name: fields[0]}.Tab()
+	if !ok || args == nil {
+		return line, nil
+	}
+
+	// the argument may contain spaces, everything after the command name is the prefix
+	name := fields[0]
+	prefix := strings.TrimLeft(strings.TrimPrefix(strings.TrimLeft(line, " "), name), " ")
+	matches := filterPrefix(args(tab), prefix)
+	if len(matches) == 1 {
+		return name + " " + matches[0], matches
+	}
+
+	return name + " " + commonPrefix(matches, prefix), matches
+}
+
+// commandNamesWithAliases returns the sorted command names and aliases, completion shows them in this order.
+func commandNamesWithAliases() []string {
+	names := []string{ScaleCommand, DeleteCommand, QuitCommand}
+	for _, tc := range tabCommands() {
+		names = append(names, tc.names...)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func filterPrefix(values []string, prefix string) []string {
+	var res []string
+	for i := range values {
+		if strings.HasPrefix(values[i], prefix) {
+			res = append(res, values[i])
+		}
+	}
+
+	return res
+}
+
+// commonPrefix returns the longest common prefix of the values, or the typed prefix if there are no values.
+func commonPrefix(values []string, prefix string) string {
+	if len(values) == 0 {
+		return prefix
+	}
+
+	// trimmed by runes: names may have multi-byte characters which must not be cut in the middle
+	res := []rune(values[0])
+	for _, v := range values[1:] {
+		for !strings.HasPrefix(v, string(res)) {
+			res = res[:len(res)-1]
+		}
+	}
+
+	return string(res)
+}
+
+// maxHistoryLen is the number of the command lines kept in the history.
+const maxHistoryLen = 100
+
+// CommandHistory keeps the run command lines, they are walked from the last one with `Prev` and back with `Next`.
+type CommandHistory struct {
+	lines []string
+	// pos is the index of the shown line, it's equal to the length while the new line is typed.
+	pos int
+}
+
+// Add adds the line to the end of the history and resets the position. The repeated line is not added twice.
+func (h *CommandHistory) Add(line string) {
+	line = strings.TrimSpace(line)
+	if line != "" && (len(h.lines) == 0 || h.lines[len(h.lines)-1] != line) {
+		h.lines = append(h.lines, line)
+		if len(h.lines) > maxHistoryLen {
+			h.lines = h.lines[len(h.lines)-maxHistoryLen:]
+		}
+	}
+	h.Reset()
+}
+
+// Reset moves the position after the last line.
+func (h *CommandHistory) Reset() {
+	h.pos = len(h.lines)
+}
+
+// Prev returns the previous line. The first line is returned again at the beginning of the history.
+func (h *CommandHistory) Prev() (string, bool) {
+	if len(h.lines) == 0 {
+		return "", false
+	}
+	if h.pos > 0 {
+		h.pos--
+	}
+
+	return h.lines[h.pos], true
+}
+
+// Next returns the next line. False is returned after the last line, the typed line is shown then.
+func (h *CommandHistory) Next() (string, bool) {
+	if h.pos >= len(h.lines)-1 {
+		h.Reset()
+
+		return "", false
+	}
+	h.pos++
+
+	return h.lines[h.pos], true
+}
@@ -0,0 +1,229 @@
+package shared
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseCommand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("resource with argument", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		cmd, err := ParseCommand("  ns   kube-system ")
+		rq.NoError(err)
+		rq.Equal(Command{Name: "ns", Args: []string{"kube-system"}}, cmd)
+
+		tab, ok := cmd.Tab()
+		rq.True(ok)
+		rq.Equal(NamespacesTab, tab)
+	})
+
+	t.Run("alias", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		cmd, err := ParseCommand("deploy")
+		rq.NoError(err)
+
+		tab, ok := cmd.Tab()
+		rq.True(ok)
+		rq.Equal(DeploymentsTab, tab)
+	})
+
+	t.Run("action", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		cmd, err := ParseCommand("scale 3")
+		rq.NoError(err)
+		rq.Equal(ScaleCommand, cmd.Name)
+		rq.Equal("3", cmd.Arg())
+
+		_, ok := cmd.Tab()
+		rq.False(ok)
+	})
+
+	t.Run("argument with spaces", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		cmd, err := ParseCommand("ns " + AllNamespacesTitle)
+		rq.NoError(err)
+		rq.Equal(AllNamespacesTitle, cmd.Arg())
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		_, err := ParseCommand("foo")
+		rq.ErrorIs(err, ErrUnknownCommand)
+
+		_, err = ParseCommand(" ")
+		rq.ErrorIs(err, ErrUnknownCommand)
+	})
+}
+
+func Test_CompleteCommand(t *testing.T) {
+	t.Parallel()
+
+	args := func(tab TabItem) []string {
+		if tab == NamespacesTab {
+			return []string{"default", "kube-public", "kube-system"}
+		}
+
+		return nil
+	}
+
+	t.Run("unique name", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		line, matches := CompleteCommand("deploy", args)
+		rq.Equal("deploy", line)
+		rq.Equal([]string{"deploy", "deployment", "deployments"}, matches)
+
+		line, matches = CompleteCommand("sc", args)
+		rq.Equal("scale ", line)
+		rq.Equal([]string{"scale"}, matches)
+	})
+
+	t.Run("common prefix", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		line, matches := CompleteCommand("co", args)
+		rq.Equal("con", line)
+		rq.Equal([]string{"configmap", "configmaps", "context", "contexts"}, matches)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		line, matches := CompleteCommand("xyz", args)
+		rq.Equal("xyz", line)
+		rq.Empty(matches)
+	})
+
+	t.Run("argument", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		line, matches := CompleteCommand("ns kube", args)
+		rq.Equal("ns kube-", line)
+		rq.Equal([]string{"kube-public", "kube-system"}, matches)
+
+		line, matches = CompleteCommand("ns kube-s", args)
+		rq.Equal("ns kube-system", line)
+		rq.Equal([]string{"kube-system"}, matches)
+
+		line, matches = CompleteCommand("ns ", args)
+		rq.Equal("ns ", line)
+		rq.Len(matches, 3)
+	})
+
+	t.Run("argument of action", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		line, matches := CompleteCommand("scale 3", args)
+		rq.Equal("scale 3", line)
+		rq.Empty(matches)
+	})
+}
+
+func Test_commonPrefix(t *testing.T) {
+	t.Parallel()
+
+	t.Run("common prefix", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "kube-", commonPrefix([]string{"kube-public", "kube-system"}, "ku"))
+	})
+
+	t.Run("no values", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "ku", commonPrefix(nil, "ku"))
+	})
+
+	t.Run("multi-byte characters", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		// "ï" and "î" have the same first byte
+		res := commonPrefix([]string{"naïve", "naîve"}, "na")
+		rq.Equal("na", res)
+		rq.True(utf8.ValidString(res))
+	})
+}
+
+func Test_CommandHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		var h CommandHistory
+		_, ok := h.Prev()
+		rq.False(ok)
+		_, ok = h.Next()
+		rq.False(ok)
+	})
+
+	t.Run("walk", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		var h CommandHistory
+		h.Add("pods")
+		h.Add("ns default")
+		h.Add("ns default")
+		h.Add(" ")
+
+		line, ok := h.Prev()
+		rq.True(ok)
+		rq.Equal("ns default", line)
+
+		line, ok = h.Prev()
+		rq.True(ok)
+		rq.Equal("pods", line)
+
+		// the first line stays
+		line, ok = h.Prev()
+		rq.True(ok)
+		rq.Equal("pods", line)
+
+		line, ok = h.Next()
+		rq.True(ok)
+		rq.Equal("ns default", line)
+
+		_, ok = h.Next()
+		rq.False(ok)
+
+		// adding resets the position
+		h.Prev()
+		h.Add("deploy")
+		line, _ = h.Prev()
+		rq.Equal("deploy", line)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		var h CommandHistory
+		for i := 0; i <= maxHistoryLen; i++ {
+			h.Add(string(rune('a'+i%26)) + string(rune('a'+i/26)))
+		}
+		rq.Len(h.lines, maxHistoryLen)
+		rq.Equal("ba", h.lines[0])
+	})
+}
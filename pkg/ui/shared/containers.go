@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+const infoIndent = "  "
+
+// RenderContainersInfo renders containers of the pod template for the info bars of workloads.
+func RenderContainersInfo(cc []domain.Container) string {
+	var info strings.Builder
+
+	info.WriteString(boldText.Render("Containers"))
+	info.WriteString("\n")
+	for i := range cc {
+		info.WriteString(infoIndent)
+		info.WriteString(fmt.Sprintf("Name: %s", cc[i].Name))
+		info.WriteString("\n")
+		info.WriteString(infoIndent)
+		info.WriteString(fmt.Sprintf("Image: %s", cc[i].Image))
+		info.WriteString("\n")
+		info.WriteString(infoIndent)
+		info.WriteString(fmt.Sprintf("Policy: %s", cc[i].ImagePullPolicy))
+		info.WriteString("\n")
+		info.WriteString(infoIndent)
+		info.WriteString(fmt.Sprintf("Termination message path: %s", cc[i].TerminationMessagePath))
+		info.WriteString("\n")
+		if len(cc[i].ENVs) > 0 {
+			info.WriteString(infoIndent)
+			info.WriteString(boldText.Render("Envs"))
+			info.WriteString("\n")
+			for j := range cc[i].ENVs {
+				info.WriteString(infoIndent)
+				info.WriteString(infoIndent)
+				info.WriteString(cc[i].ENVs[j].Name)
+				info.WriteString("\n")
+			}
+		}
+	}
+
+	return info.String()
+}
@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+func Test_RenderContainersInfo(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	info := RenderContainersInfo([]domain.Container{
+		{
+			Name:  "app",
+			Image: "app:1.0",
+			ENVs:  []domain.ContainerEnv{{Name: "MODE"}},
+		},
+		{
+			Name:  "sidecar",
+			Image: "proxy:2.0",
+		},
+	})
+
+	rq.Contains(info, "Name: app")
+	rq.Contains(info, "Image: app:1.0")
+	rq.Contains(info, "    MODE\n")
+	rq.Contains(info, "Name: sidecar")
+	rq.Contains(info, "Image: proxy:2.0")
+}
@@ -0,0 +1,76 @@
+package shared
+
+import (
+	"strings"
+
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const (
+	diffSame    = "  "
+	diffRemoved = "- "
+	diffAdded   = "+ "
+)
+
+// DiffLines returns a line by line difference of two texts based on the longest common subsequence.
+// Every line is prefixed with `- ` for removed, `+ ` for added and two spaces for not changed lines.
+func DiffLines(from, to string) []string {
+	a := strings.Split(strings.TrimSuffix(from, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(to, "\n"), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = Max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]string, 0, Max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffSame+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffRemoved+a[i])
+			i++
+		default:
+			lines = append(lines, diffAdded+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffRemoved+a[i])
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffAdded+b[j])
+	}
+
+	return lines
+}
+
+// RenderDiff renders the difference of two texts, removed lines are highlighted with the warning style
+// and added lines with the namespace sign style.
+func RenderDiff(from, to string, styles *themes.Styles) string {
+	lines := DiffLines(from, to)
+	for i := range lines {
+		switch {
+		case strings.HasPrefix(lines[i], diffRemoved):
+			lines[i] = styles.WarningText.Render(lines[i])
+		case strings.HasPrefix(lines[i], diffAdded):
+			lines[i] = styles.NamespaceSign.Render(lines[i])
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
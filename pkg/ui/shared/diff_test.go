@@ -0,0 +1,39 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DiffLines(t *testing.T) {
+	t.Parallel()
+
+	t.Run("changed line", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, []string{
+			"  containers:",
+			"- image: app:1",
+			"+ image: app:2",
+			"  name: app",
+		}, DiffLines("containers:\nimage: app:1\nname: app\n", "containers:\nimage: app:2\nname: app\n"))
+	})
+
+	t.Run("added and removed lines", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, []string{
+			"- a",
+			"  b",
+			"  c",
+			"+ d",
+		}, DiffLines("a\nb\nc", "b\nc\nd"))
+	})
+
+	t.Run("equal", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, []string{"  a", "  b"}, DiffLines("a\nb", "a\nb"))
+	})
+}
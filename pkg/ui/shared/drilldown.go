@@ -0,0 +1,51 @@
+package shared
+
+import (
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+const breadcrumbSeparator = " › "
+
+// PodsOwner is the workload which pods are shown by the drill down from its tab.
+// Workloads don't always own pods directly, deployment pods are owned by its replica sets,
+// so PodOwners keeps the direct owners of the pods resolved when the drill down is started.
+// Replica sets created by the deployment later are added by the pods tab when their pods come.
+type PodsOwner struct {
+	// Tab is the tab of the workload, it's opened again by the back key.
+	Tab       TabItem
+	Namespace string
+	Name      string
+	PodOwners []domain.OwnerInfo
+}
+
+// ShowPodsMsg opens the pods tab with the pods of the owner only.
+type ShowPodsMsg struct {
+	Owner PodsOwner
+}
+
+// BackMsg returns from the pods of the owner to the owner tab, the owner is selected there.
+type BackMsg struct {
+	Owner PodsOwner
+}
+
+// Owns returns true if the pod belongs to the owner.
+func (o *PodsOwner) Owns(p *domain.Pod) bool {
+	if p.Namespace != o.Namespace {
+		return false
+	}
+
+	for _, po := range p.Meta.Owners {
+		for _, oo := range o.PodOwners {
+			if po.Kind == oo.Kind && po.Name == oo.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Breadcrumb returns the path to the pods of the owner, like `Deployments › nginx › Pods`.
+func (o *PodsOwner) Breadcrumb() string {
+	return o.Tab.String() + breadcrumbSeparator + o.Name + breadcrumbSeparator + PodsTab.String()
+}
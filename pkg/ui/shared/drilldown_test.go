@@ -0,0 +1,59 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+func Test_PodsOwner(t *testing.T) {
+	t.Parallel()
+
+	owner := PodsOwner{
+		Tab:       DeploymentsTab,
+		Namespace: "default",
+		Name:      "app",
+		PodOwners: []domain.OwnerInfo{
+			{Kind: "ReplicaSet", Name: "app-1"},
+			{Kind: "ReplicaSet", Name: "app-2"},
+		},
+	}
+
+	newPod := func(namespace string, owners ...domain.OwnerInfo) *domain.Pod {
+		return &domain.Pod{
+			Namespace: namespace,
+			Meta:      domain.PodMeta{Owners: owners},
+		}
+	}
+
+	t.Run("owns", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		rq.True(owner.Owns(newPod("default", domain.OwnerInfo{Kind: "ReplicaSet", Name: "app-2"})))
+	})
+
+	t.Run("another owner", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		rq.False(owner.Owns(newPod("default", domain.OwnerInfo{Kind: "ReplicaSet", Name: "other-1"})))
+		rq.False(owner.Owns(newPod("default", domain.OwnerInfo{Kind: "StatefulSet", Name: "app-1"})))
+		rq.False(owner.Owns(newPod("default")))
+	})
+
+	t.Run("another namespace", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		rq.False(owner.Owns(newPod("team", domain.OwnerInfo{Kind: "ReplicaSet", Name: "app-1"})))
+	})
+
+	t.Run("breadcrumb", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		rq.Equal("Deployments › app › Pods", owner.Breadcrumb())
+	})
+}
@@ -0,0 +1,185 @@
+/*
+Package confirm keeps a confirmation dialog for actions which change resources.
+*/
+package confirm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	dialogPaddingV = 1
+	dialogPaddingH = 2
+	// maxGracePeriodLen limits the number of grace period digits, it's enough for a year in seconds.
+	maxGracePeriodLen = 8
+	checkedBox        = "[x]"
+	uncheckedBox      = "[ ]"
+)
+
+// DeleteAction is an action of the delete dialog.
+// Grace period is nil if user keeps the default grace period of the object.
+type DeleteAction func(gracePeriod *int64, force bool) tea.Cmd
+
+// Model is a confirmation dialog. While it's open, it must receive all the keys.
+type Model struct {
+	question string
+	action   tea.Cmd
+	open     bool
+	// deleteAction is set for the delete dialog, which has grace period and force options.
+	deleteAction DeleteAction
+	gracePeriod  string
+	force        bool
+}
+
+func New() *Model {
+	return &Model{}
+}
+
+// Ask opens the dialog with the question. The action is returned as a command by `Update` if user confirms it.
+func (m *Model) Ask(question string, action tea.Cmd) {
+	m.question = question
+	m.action = action
+	m.open = true
+}
+
+// AskDelete opens the dialog with the question and the delete options: the grace period, which is typed by digits,
+// and force deletion. The action is called with the chosen options if user confirms it.
+func (m *Model) AskDelete(question string, action DeleteAction) {
+	m.question = question
+	m.deleteAction = action
+	m.gracePeriod = ""
+	m.force = false
+	m.open = true
+}
+
+// Open returns true if the dialog waits for the answer.
+func (m *Model) Open() bool {
+	return m.open
+}
+
+// Close closes the dialog without running the action.
+func (m *Model) Close() {
+	m.open = false
+	m.action = nil
+	m.deleteAction = nil
+}
+
+func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.open {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, keys.Yes):
+		action := m.action
+		if m.deleteAction != nil {
+			action = m.deleteAction(m.getGracePeriod(), m.force)
+		}
+		m.Close()
+
+		return m, action
+	case key.Matches(keyMsg, keys.No):
+		m.Close()
+	case m.deleteAction != nil:
+		m.updateDeleteOptions(keyMsg)
+	}
+
+	return m, nil
+}
+
+func (m *Model) updateDeleteOptions(msg tea.KeyMsg) {
+	switch {
+	case key.Matches(msg, keys.Force):
+		m.force = !m.force
+	case m.force:
+		// force deletion has no grace period
+	case key.Matches(msg, keys.Backspace):
+		if m.gracePeriod != "" {
+			m.gracePeriod = m.gracePeriod[:len(m.gracePeriod)-1]
+		}
+	case msg.Type == tea.KeyRunes && len(m.gracePeriod) < maxGracePeriodLen:
+		for _, r := range msg.Runes {
+			if r < '0' || r > '9' {
+				return
+			}
+		}
+		m.gracePeriod += string(msg.Runes)
+	}
+}
+
+// getGracePeriod returns nil for the default grace period. Force deletion is immediate.
+func (m *Model) getGracePeriod() *int64 {
+	if m.force {
+		var zero int64
+
+		return &zero
+	}
+
+	if m.gracePeriod == "" {
+		return nil
+	}
+
+	v, err := strconv.ParseInt(m.gracePeriod, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &v
+}
+
+// View renders the dialog in the center of the area with the given size.
+func (m *Model) View(width, height int, st lipgloss.Style) string {
+	if !m.open {
+		return ""
+	}
+
+	lines := []string{st.Render(m.question), ""}
+	if m.deleteAction != nil {
+		lines = append(lines, m.renderDeleteOptions(st)...)
+	}
+	lines = append(lines, fmt.Sprintf("%s %s    %s %s",
+		keys.Yes.Help().Key, keys.Yes.Help().Desc,
+		keys.No.Help().Key, keys.No.Help().Desc))
+
+	dialog := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(st.GetForeground()).
+		Padding(dialogPaddingV, dialogPaddingH).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, dialog)
+}
+
+func (m *Model) renderDeleteOptions(st lipgloss.Style) []string {
+	var grace strings.Builder
+	grace.WriteString("Grace period: ")
+	switch {
+	case m.force:
+		grace.WriteString("0s")
+	case m.gracePeriod == "":
+		grace.WriteString("default")
+	default:
+		grace.WriteString(m.gracePeriod + "s")
+	}
+	if !m.force {
+		grace.WriteString("  (type seconds)")
+	}
+
+	box := uncheckedBox
+	if m.force {
+		box = checkedBox
+	}
+
+	return []string{
+		st.Render(grace.String()),
+		st.Render(fmt.Sprintf("%s %s  (%s)", box, keys.Force.Help().Desc, keys.Force.Help().Key)),
+		"",
+	}
+}
@@ -0,0 +1,31 @@
+package confirm
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type keyMap struct {
+	Yes       key.Binding
+	No        key.Binding
+	Force     key.Binding
+	Backspace key.Binding
+}
+
+// nolint gochecknoglobals: used here on purpose
+var keys = keyMap{
+	Yes: key.NewBinding(
+		key.WithKeys("y", "Y"),
+		key.WithHelp("y", "confirm"),
+	),
+	No: key.NewBinding(
+		key.WithKeys("n", "N", "esc", "q"),
+		key.WithHelp("n/esc", "cancel"),
+	),
+	Force: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "force"),
+	),
+	Backspace: key.NewBinding(
+		key.WithKeys("backspace"),
+	),
+}
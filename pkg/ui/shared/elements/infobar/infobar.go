@@ -17,6 +17,8 @@ type Model struct {
 	width    int
 	height   int
 	viewport viewport.Model
+	// maxLines limits the content grown by `AppendLines`, zero means no limit.
+	maxLines int
 }
 
 func New() *Model {
@@ -79,6 +81,34 @@ func (m *Model) SetContent(data string) {
 	m.viewport.SetContent(data)
 }
 
+// SetMaxLines sets the number of lines kept by `AppendLines`, the oldest lines are dropped above it.
+func (m *Model) SetMaxLines(n int) {
+	m.maxLines = n
+}
+
+// AppendLines adds lines to the end of the content, the first lines are dropped above the max lines limit.
+// If the view is at the bottom, it follows the new lines. If user has scrolled up, the view stays where it is.
+func (m *Model) AppendLines(lines ...string) {
+	atBottom := m.viewport.AtBottom()
+	m.viewport.AppendLines(lines...)
+	if m.maxLines > 0 {
+		m.viewport.TrimTop(m.maxLines)
+	}
+	if atBottom {
+		m.viewport.GotoBottom()
+	}
+}
+
+// ShowLine scrolls the view the minimal distance to make the line with index n visible.
+func (m *Model) ShowLine(n int) {
+	switch {
+	case n < m.viewport.YOffset:
+		m.viewport.SetYOffset(n)
+	case n >= m.viewport.YOffset+m.viewport.Height:
+		m.viewport.SetYOffset(n - m.viewport.Height + 1)
+	}
+}
+
 func (m *Model) SetWH(w, h int) {
 	m.width = w
 	m.height = h - continueReadHeight
@@ -0,0 +1,26 @@
+package prompt
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type keyMap struct {
+	Submit    key.Binding
+	Cancel    key.Binding
+	Backspace key.Binding
+}
+
+// nolint gochecknoglobals: used here on purpose
+var keys = keyMap{
+	Submit: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "submit"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "cancel"),
+	),
+	Backspace: key.NewBinding(
+		key.WithKeys("backspace"),
+	),
+}
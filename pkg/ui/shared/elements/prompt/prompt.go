@@ -0,0 +1,118 @@
+/*
+Package prompt keeps an inline dialog which asks user for a number.
+*/
+package prompt
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	dialogPaddingV = 1
+	dialogPaddingH = 2
+	// maxValueLen limits the number of typed digits.
+	maxValueLen = 6
+	cursor      = "_"
+)
+
+// Action is called with the typed number when user submits it.
+type Action func(value int) tea.Cmd
+
+// Model is a number prompt dialog. While it's open, it must receive all the keys.
+type Model struct {
+	question string
+	value    string
+	action   Action
+	open     bool
+}
+
+func New() *Model {
+	return &Model{}
+}
+
+// Ask opens the prompt with the question and the initial value.
+// The action is returned as a command by `Update` when user submits the value.
+func (m *Model) Ask(question string, initial int, action Action) {
+	m.question = question
+	m.value = strconv.Itoa(initial)
+	m.action = action
+	m.open = true
+}
+
+// Open returns true if the prompt waits for the value.
+func (m *Model) Open() bool {
+	return m.open
+}
+
+// Close closes the prompt without running the action.
+func (m *Model) Close() {
+	m.open = false
+	m.action = nil
+}
+
+func (m *Model) Update(msg tea.Msg) (*Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.open {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, keys.Submit):
+		v, err := strconv.Atoi(m.value)
+		if err != nil {
+			// nothing is typed
+			return m, nil
+		}
+		cmd := m.action(v)
+		m.Close()
+
+		return m, cmd
+	case key.Matches(keyMsg, keys.Cancel):
+		m.Close()
+	case key.Matches(keyMsg, keys.Backspace):
+		if m.value != "" {
+			m.value = m.value[:len(m.value)-1]
+		}
+	case keyMsg.Type == tea.KeyRunes && len(m.value) < maxValueLen:
+		for _, r := range keyMsg.Runes {
+			if r < '0' || r > '9' {
+				return m, nil
+			}
+		}
+		// leading zero is replaced by the typed number
+		if m.value == "0" {
+			m.value = ""
+		}
+		m.value += string(keyMsg.Runes)
+	}
+
+	return m, nil
+}
+
+// View renders the prompt in the center of the area with the given size.
+func (m *Model) View(width, height int, st lipgloss.Style) string {
+	if !m.open {
+		return ""
+	}
+
+	dialog := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(st.GetForeground()).
+		Padding(dialogPaddingV, dialogPaddingH).
+		Render(lipgloss.JoinVertical(lipgloss.Left,
+			st.Render(m.question),
+			"",
+			st.Copy().Bold(true).Render("> "+m.value+cursor),
+			"",
+			fmt.Sprintf("%s %s    %s %s",
+				keys.Submit.Help().Key, keys.Submit.Help().Desc,
+				keys.Cancel.Help().Key, keys.Cancel.Help().Desc),
+		))
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, dialog)
+}
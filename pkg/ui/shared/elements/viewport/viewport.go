@@ -117,6 +117,27 @@ func (m *Model) SetContent(s string) {
 	}
 }
 
+// AppendLines adds lines to the end of the content.
+func (m *Model) AppendLines(lines ...string) {
+	// SetContent keeps empty content as a single empty line, it must not be the first line
+	if len(m.lines) == 1 && m.lines[0] == "" {
+		m.lines = nil
+	}
+	m.lines = append(m.lines, lines...)
+}
+
+// TrimTop drops the first lines above the limit. The offset is moved along, so the same lines stay in the view
+// unless they are dropped.
+func (m *Model) TrimTop(limit int) {
+	if len(m.lines) <= limit {
+		return
+	}
+
+	n := len(m.lines) - limit
+	m.lines = m.lines[n:]
+	m.YOffset = max(0, m.YOffset-n)
+}
+
 // maxYOffset returns the maximum possible value of the y-offset based on the
 // viewport's content and set height.
 func (m Model) maxYOffset() int {
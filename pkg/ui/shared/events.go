@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+const noEventsText = "No events"
+
+// RenderEvents renders events timeline of the object for the info bars, the last seen first.
+// Warning events are highlighted with the warning style.
+func RenderEvents(events []domain.Event, styles *themes.Styles) string {
+	if len(events) == 0 {
+		return infoIndent + noEventsText
+	}
+
+	var info strings.Builder
+	for i := range events {
+		title := fmt.Sprintf("%s ago  %s  %s", events[i].LastSeen, events[i].Type, events[i].Reason)
+		if events[i].Count > 1 {
+			title += fmt.Sprintf(" (x%d)", events[i].Count)
+		}
+		if events[i].Type == domain.EventTypeWarning {
+			title = styles.WarningText.Render(title)
+		}
+
+		info.WriteString(title)
+		info.WriteString("\n")
+		info.WriteString(infoIndent)
+		info.WriteString(events[i].Message)
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
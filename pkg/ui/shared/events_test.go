@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+	"github.com/tty2/kubic/pkg/ui/shared/themes"
+)
+
+func Test_RenderEvents(t *testing.T) {
+	t.Parallel()
+
+	styles := themes.GetStyle(themes.Theme{})
+
+	t.Run("events", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		info := RenderEvents([]domain.Event{
+			{Type: "Warning", Reason: "BackOff", Message: "Back-off restarting failed container", Count: 5, LastSeen: "1m"},
+			{Type: "Normal", Reason: "Pulled", Message: "Container image pulled", Count: 1, LastSeen: "2h"},
+		}, &styles)
+
+		rq.Contains(info, "1m ago  Warning  BackOff (x5)")
+		rq.Contains(info, "  Back-off restarting failed container\n")
+		rq.Contains(info, "2h ago  Normal  Pulled\n")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "  No events", RenderEvents(nil, &styles))
+	})
+}
@@ -10,41 +10,175 @@ import (
 var boldText = lipgloss.NewStyle().Bold(true)
 
 type KeyMap struct {
-	Tab        key.Binding
-	ShiftTab   key.Binding
-	Up         key.Binding
-	Down       key.Binding
-	PrevPage   key.Binding
-	NextPage   key.Binding
-	FocusRight key.Binding
-	FocusLeft  key.Binding
-	Select     key.Binding
-	Help       key.Binding
-	HelpShort  key.Binding
-	Quit       key.Binding
+	Tab         key.Binding
+	ShiftTab    key.Binding
+	Up          key.Binding
+	Down        key.Binding
+	PrevPage    key.Binding
+	NextPage    key.Binding
+	FocusRight  key.Binding
+	FocusLeft   key.Binding
+	Select      key.Binding
+	Filter      key.Binding
+	ClearFilter key.Binding
+	// SortColumn sorts the list by the next column and ReverseSort changes the sort direction.
+	SortColumn  key.Binding
+	ReverseSort key.Binding
+	Help        key.Binding
+	HelpShort   key.Binding
+	Quit        key.Binding
+	// Command opens the command line.
+	Command key.Binding
+	// DismissError hides the error banner.
+	DismissError key.Binding
+	// pods
+	NextContainer key.Binding
+	PreviousLogs  key.Binding
+	DeletePod     key.Binding
+	ExecShell     key.Binding
+	// deployments
+	RestartDeployment key.Binding
+	ScaleDeployment   key.Binding
+	PauseDeployment   key.Binding
+	// RollbackDeployment rolls back to the revision selected in the history.
+	RollbackDeployment key.Binding
+	// ShowPods drills down to the pods of the selected deployment, Back returns to the deployment.
+	ShowPods key.Binding
+	Back     key.Binding
+	// secrets
+	RevealSecret key.Binding
+	// cron jobs
+	TriggerCronJob key.Binding
+	SuspendCronJob key.Binding
+	// nodes
+	CordonNode key.Binding
+	DrainNode  key.Binding
+	StopDrain  key.Binding
+	// events
+	RefreshEvents key.Binding
+	// port forwards
+	// PortForward starts a port forward to the selected pod or service.
+	PortForward        key.Binding
+	StopPortForward    key.Binding
+	RestartPortForward key.Binding
+	RemovePortForward  key.Binding
 }
 
 func (k KeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Help, k.Quit, k.Tab, k.Select}
+	return []key.Binding{k.Help, k.Quit, k.Tab, k.Command, k.Select}
 }
 
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.HelpShort, k.Quit, k.Tab},
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
 		{k.Up, k.Down, k.PrevPage, k.NextPage},
 		{k.Select},
+		{k.Filter, k.ClearFilter},
+	}
+}
+
+// FullNamespacesHelp is the full help of the namespaces list, it can be sorted unlike the contexts list.
+func (k KeyMap) FullNamespacesHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
+		{k.Up, k.Down, k.PrevPage, k.NextPage},
+		{k.Select},
+		{k.Filter, k.ClearFilter, k.SortColumn, k.ReverseSort},
+	}
+}
+
+func (k KeyMap) FullPodsHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
+		{k.Up, k.Down, k.PrevPage, k.NextPage},
+		{k.FocusLeft, k.FocusRight},
+		{k.Filter, k.ClearFilter, k.SortColumn, k.ReverseSort},
+		{k.NextContainer, k.PreviousLogs, k.RefreshEvents},
+		{k.DeletePod, k.ExecShell, k.PortForward, k.Back},
+	}
+}
+
+func (k KeyMap) FullServicesHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
+		{k.Up, k.Down, k.PrevPage, k.NextPage},
+		{k.FocusLeft, k.FocusRight},
+		{k.Filter, k.ClearFilter},
+		{k.PortForward},
+	}
+}
+
+func (k KeyMap) FullDeploymentsHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
+		{k.Up, k.Down, k.PrevPage, k.NextPage},
+		{k.FocusLeft, k.FocusRight},
+		{k.Filter, k.ClearFilter, k.SortColumn, k.ReverseSort},
+		{k.RestartDeployment, k.ScaleDeployment, k.PauseDeployment},
+		{k.RollbackDeployment, k.RefreshEvents, k.ShowPods},
+	}
+}
+
+func (k KeyMap) FullSecretsHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
+		{k.Up, k.Down, k.PrevPage, k.NextPage},
+		{k.FocusLeft, k.FocusRight},
+		{k.Filter, k.ClearFilter},
+		{k.RevealSecret},
+	}
+}
+
+func (k KeyMap) FullCronJobsHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
+		{k.Up, k.Down, k.PrevPage, k.NextPage},
+		{k.FocusLeft, k.FocusRight},
+		{k.Filter, k.ClearFilter},
+		{k.TriggerCronJob, k.SuspendCronJob},
+	}
+}
+
+func (k KeyMap) FullNodesHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
+		{k.Up, k.Down, k.PrevPage, k.NextPage},
+		{k.FocusLeft, k.FocusRight},
+		{k.Filter, k.ClearFilter},
+		{k.CordonNode, k.DrainNode, k.StopDrain},
+	}
+}
+
+func (k KeyMap) FullEventsHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
+		{k.Up, k.Down, k.PrevPage, k.NextPage},
+		{k.FocusLeft, k.FocusRight},
+		{k.Filter, k.ClearFilter},
+		{k.RefreshEvents},
+	}
+}
+
+func (k KeyMap) FullPortForwardsHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
+		{k.Up, k.Down, k.PrevPage, k.NextPage},
+		{k.FocusLeft, k.FocusRight},
+		{k.Filter, k.ClearFilter},
+		{k.StopPortForward, k.RestartPortForward, k.RemovePortForward},
 	}
 }
 
 func (k KeyMap) ShortWithFocus() []key.Binding {
-	return []key.Binding{k.Help, k.Quit, k.Tab, k.FocusRight}
+	return []key.Binding{k.Help, k.Quit, k.Tab, k.Command, k.FocusRight}
 }
 
 func (k KeyMap) FullWithFocus() [][]key.Binding {
 	return [][]key.Binding{
-		{k.HelpShort, k.Quit, k.Tab},
+		{k.HelpShort, k.Quit, k.Tab, k.Command},
 		{k.Up, k.Down, k.PrevPage, k.NextPage},
 		{k.FocusLeft, k.FocusRight},
+		{k.Filter, k.ClearFilter},
 	}
 }
 
@@ -83,6 +217,22 @@ func GetKeyMaps() KeyMap {
 			key.WithKeys(tea.KeyCtrlH.String(), tea.KeyCtrlLeft.String()),
 			key.WithHelp(boldText.Render("Ctrl+←/Ctrl+h"), "focus left"),
 		),
+		Filter: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp(boldText.Render("/"), "filter"),
+		),
+		ClearFilter: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp(boldText.Render("esc"), "clear filter"),
+		),
+		SortColumn: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp(boldText.Render("o"), "sort by next column"),
+		),
+		ReverseSort: key.NewBinding(
+			key.WithKeys("O"),
+			key.WithHelp(boldText.Render("O"), "reverse sort"),
+		),
 		Help: key.NewBinding(
 			key.WithKeys("?"),
 			key.WithHelp(boldText.Render("?"), "full help"),
@@ -95,9 +245,101 @@ func GetKeyMaps() KeyMap {
 			key.WithKeys(tea.KeyEnter.String()),
 			key.WithHelp(boldText.Render("Enter"), "select item"),
 		),
+		NextContainer: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp(boldText.Render("c"), "next container logs"),
+		),
+		PreviousLogs: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp(boldText.Render("p"), "previous container logs"),
+		),
+		DeletePod: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp(boldText.Render("d"), "delete pod"),
+		),
+		ExecShell: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp(boldText.Render("e"), "exec shell"),
+		),
+		RestartDeployment: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp(boldText.Render("R"), "rollout restart"),
+		),
+		ScaleDeployment: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp(boldText.Render("s"), "scale"),
+		),
+		PauseDeployment: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp(boldText.Render("p"), "pause/resume rollout"),
+		),
+		RollbackDeployment: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp(boldText.Render("u"), "roll back to revision"),
+		),
+		ShowPods: key.NewBinding(
+			key.WithKeys(tea.KeyEnter.String()),
+			key.WithHelp(boldText.Render("Enter"), "show pods"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys(tea.KeyBackspace.String()),
+			key.WithHelp(boldText.Render("backspace"), "back to deployment"),
+		),
+		RevealSecret: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp(boldText.Render("r"), "reveal/hide secret value"),
+		),
+		TriggerCronJob: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp(boldText.Render("t"), "trigger job now"),
+		),
+		SuspendCronJob: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp(boldText.Render("s"), "suspend/resume"),
+		),
+		CordonNode: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp(boldText.Render("c"), "cordon/uncordon"),
+		),
+		DrainNode: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp(boldText.Render("d"), "drain"),
+		),
+		StopDrain: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp(boldText.Render("x"), "stop drain"),
+		),
+		RefreshEvents: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp(boldText.Render("r"), "refresh events"),
+		),
+		PortForward: key.NewBinding(
+			key.WithKeys("f"),
+			key.WithHelp(boldText.Render("f"), "port forward"),
+		),
+		StopPortForward: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp(boldText.Render("x"), "stop forward"),
+		),
+		RestartPortForward: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp(boldText.Render("r"), "restart forward"),
+		),
+		RemovePortForward: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp(boldText.Render("d"), "remove forward"),
+		),
 		Quit: key.NewBinding(
-			key.WithKeys("q", "esc", "ctrl+c"),
+			key.WithKeys("q", "ctrl+c"),
 			key.WithHelp(boldText.Render("q"), "quit"),
 		),
+		DismissError: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp(boldText.Render("esc"), "dismiss error"),
+		),
+		Command: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(boldText.Render(":"), "command"),
+		),
 	}
 }
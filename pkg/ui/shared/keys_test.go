@@ -0,0 +1,30 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_KeyMap_Quit(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	keyMap := GetKeyMaps()
+
+	t.Run("quit keys", func(t *testing.T) {
+		t.Parallel()
+
+		rq.True(key.Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}, keyMap.Quit))
+		rq.True(key.Matches(tea.KeyMsg{Type: tea.KeyCtrlC}, keyMap.Quit))
+	})
+
+	t.Run("esc doesn't quit", func(t *testing.T) {
+		t.Parallel()
+
+		// esc clears the filter and dismisses the error, pressing it once more must not exit
+		rq.False(key.Matches(tea.KeyMsg{Type: tea.KeyEsc}, keyMap.Quit))
+	})
+}
@@ -0,0 +1,193 @@
+package shared
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	filterPrompt = "/"
+	// labelsSeparator separates item name and its labels in the filter value.
+	labelsSeparator = " "
+	labelSign       = "="
+	headerGap       = "  "
+	loadingText     = " loading"
+)
+
+// SetupFiltering enables the list filter with the prompt and the filter function used by all the lists.
+func SetupFiltering(l *list.Model, styles lipgloss.Style) {
+	l.SetFilteringEnabled(true)
+	l.SetShowFilter(false) // the filter is rendered in the table header
+	l.Filter = Filter
+	l.FilterInput.Prompt = filterPrompt
+	l.FilterInput.PromptStyle = styles
+	l.FilterInput.CursorStyle = styles
+}
+
+// FilterValueWithLabels returns item filter value that contains both name and labels.
+// Use it as `FilterValue` for items which can be filtered by labels.
+func FilterValueWithLabels(name string, labels map[string]string) string {
+	ll := make([]string, 0, len(labels))
+	for k, v := range labels {
+		ll = append(ll, k+labelSign+v)
+	}
+	sort.Strings(ll)
+
+	return strings.Join(append([]string{name}, ll...), labelsSeparator)
+}
+
+// Filter is a filter function for lists.
+// If the term has `=` sign, items are filtered by labels, every space separated `key=value` part of the term
+// must be a substring of some item label. Otherwise items are fuzzy matched by name.
+func Filter(term string, targets []string) []list.Rank {
+	if strings.Contains(term, labelSign) {
+		return filterByLabels(term, targets)
+	}
+
+	names := make([]string, len(targets))
+	for i := range targets {
+		names[i] = strings.SplitN(targets[i], labelsSeparator, 2)[0]
+	}
+
+	matches := fuzzy.Find(term, names)
+	sort.Stable(matches)
+
+	ranks := make([]list.Rank, len(matches))
+	for i := range matches {
+		ranks[i] = list.Rank{
+			Index:          matches[i].Index,
+			MatchedIndexes: matches[i].MatchedIndexes,
+		}
+	}
+
+	return ranks
+}
+
+func filterByLabels(term string, targets []string) []list.Rank {
+	parts := strings.Fields(term)
+
+	var ranks []list.Rank
+	for i := range targets {
+		labels := strings.Split(targets[i], labelsSeparator)[1:]
+		if matchAll(parts, labels) {
+			ranks = append(ranks, list.Rank{Index: i})
+		}
+	}
+
+	return ranks
+}
+
+func matchAll(parts, labels []string) bool {
+	for _, p := range parts {
+		var found bool
+		for _, l := range labels {
+			if strings.Contains(l, p) {
+				found = true
+
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterView returns filter input while user sets filter and applied filter term when filter is set.
+// It returns empty string for not filtered list.
+func FilterView(l list.Model) string {
+	switch l.FilterState() {
+	case list.Filtering:
+		return l.FilterInput.View()
+	case list.FilterApplied:
+		return l.FilterInput.PromptStyle.Render(l.FilterInput.Prompt + l.FilterValue())
+	default:
+		return ""
+	}
+}
+
+// ApplyFilter runs list filter command immediately.
+// List filters items asynchronously with a command that returns `list.FilterMatchesMsg`. Messages are not bound to
+// a specific list, so if items are changed by background updates the result can be delivered to another list.
+// Use it with commands returned by `SetItems`, `SetItem` and `InsertItem`.
+func ApplyFilter(l *list.Model, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+
+	if msg, ok := cmd().(list.FilterMatchesMsg); ok {
+		*l, _ = l.Update(msg)
+	}
+}
+
+// RemoveItem removes the item by its index in `Items` and keeps the filter applied to the rest of them.
+// `list.RemoveItem` removes the filtered item by the same index, and resets the filter if no filtered items are left.
+func RemoveItem(l *list.Model, index int) {
+	items := l.Items()
+	rest := make([]list.Item, 0, len(items)-1)
+	rest = append(rest, items[:index]...)
+	rest = append(rest, items[index+1:]...)
+
+	ApplyFilter(l, l.SetItems(rest))
+}
+
+// RenderTableHeader renders table header with the filter and the current namespace aligned to the right.
+// The spinner of the loader is shown while the list is loaded, the loader is nil for lists
+// which are not loaded from the cluster.
+func RenderTableHeader(app *App, header string, l list.Model, loader *Loader) string {
+	return renderTableHeader(app, header, l, loader, app.Styles.InactiveText.Render(app.CurrentNamespace))
+}
+
+// RenderWorkloadsTableHeader renders table header of pods and deployments which can be listed in all namespaces.
+func RenderWorkloadsTableHeader(app *App, header string, l list.Model, loader *Loader) string {
+	namespace := app.CurrentNamespace
+	if app.AllNamespaces {
+		namespace = AllNamespacesTitle
+	}
+
+	return renderTableHeader(app, header, l, loader, app.Styles.InactiveText.Render(namespace))
+}
+
+// RenderDrillDownTableHeader renders table header of pods shown by the drill down from the owner,
+// the breadcrumb is shown before the namespace of the owner.
+func RenderDrillDownTableHeader(app *App, header string, l list.Model, loader *Loader, owner *PodsOwner) string {
+	right := lipgloss.JoinHorizontal(lipgloss.Top,
+		app.Styles.NamespaceSign.Render(owner.Breadcrumb()),
+		headerGap,
+		app.Styles.InactiveText.Render(owner.Namespace),
+	)
+
+	return renderTableHeader(app, header, l, loader, right)
+}
+
+// renderTableHeader renders the header with the already styled right part.
+func renderTableHeader(app *App, header string, l list.Model, loader *Loader, right string) string {
+	if loader != nil && loader.Loading() {
+		right = lipgloss.JoinHorizontal(lipgloss.Top,
+			loader.View(), app.Styles.InactiveText.Render(loadingText), headerGap, right)
+	}
+	if filter := FilterView(l); filter != "" {
+		right = lipgloss.JoinHorizontal(lipgloss.Top, filter, headerGap, right)
+	}
+
+	gap := strings.Repeat(" ", Max(
+		len(headerGap),
+		app.GUI.ScreenWidth-lipgloss.Width(header)-lipgloss.Width(right)-app.Styles.TextRightMargin))
+
+	return app.Styles.InactiveText.Render(header+gap) + right
+}
+
+// ClearList drops the items loaded from the previous cluster on the context switch,
+// the loads started before the switch are outdated.
+func ClearList(l *list.Model, loader *Loader) {
+	loader.Reset()
+	l.ResetFilter()
+	l.SetItems(nil)
+}
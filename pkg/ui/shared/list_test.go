@@ -0,0 +1,129 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FilterValueWithLabels(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	t.Run("no labels", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal("api", FilterValueWithLabels("api", nil))
+	})
+	t.Run("sorted labels", func(t *testing.T) {
+		t.Parallel()
+
+		res := FilterValueWithLabels("api", map[string]string{
+			"tier": "backend",
+			"app":  "api",
+		})
+		rq.Equal("api app=api tier=backend", res)
+	})
+}
+
+func Test_Filter(t *testing.T) {
+	t.Parallel()
+	rq := require.New(t)
+
+	targets := []string{
+		FilterValueWithLabels("api-7d9f", map[string]string{"app": "api", "tier": "backend"}),
+		FilterValueWithLabels("web-5c6b", map[string]string{"app": "web", "tier": "frontend"}),
+		FilterValueWithLabels("worker-9a8b", map[string]string{"app": "worker", "tier": "backend"}),
+	}
+
+	t.Run("fuzzy by name", func(t *testing.T) {
+		t.Parallel()
+
+		res := Filter("wrk", targets)
+		rq.Len(res, 1)
+		rq.Equal(2, res[0].Index)
+	})
+	t.Run("name only", func(t *testing.T) {
+		t.Parallel()
+
+		// `backend` is a label value, it must not be matched without `=`
+		rq.Empty(Filter("backend", targets))
+	})
+	t.Run("by label", func(t *testing.T) {
+		t.Parallel()
+
+		res := Filter("tier=backend", targets)
+		rq.Len(res, 2)
+		rq.Equal(0, res[0].Index)
+		rq.Equal(2, res[1].Index)
+	})
+	t.Run("by several labels", func(t *testing.T) {
+		t.Parallel()
+
+		res := Filter("tier=backend app=api", targets)
+		rq.Len(res, 1)
+		rq.Equal(0, res[0].Index)
+	})
+}
+
+type testItem string
+
+func (i testItem) FilterValue() string {
+	return string(i)
+}
+
+func Test_RemoveItem(t *testing.T) {
+	t.Parallel()
+
+	newList := func(filter string) list.Model {
+		l := list.New([]list.Item{testItem("alpha"), testItem("beta"), testItem("gamma")}, list.NewDefaultDelegate(), 80, 20)
+		SetupFiltering(&l, lipgloss.NewStyle())
+		if filter == "" {
+			return l
+		}
+
+		l, _ = l.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+		l, _ = l.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(filter)})
+		ApplyFilter(&l, l.SetItems(l.Items()))
+		l, _ = l.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		return l
+	}
+
+	t.Run("unfiltered", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		l := newList("")
+		RemoveItem(&l, 1)
+		rq.Equal([]list.Item{testItem("alpha"), testItem("gamma")}, l.Items())
+	})
+
+	t.Run("filtered out item", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		l := newList("gamma")
+		rq.Equal(list.FilterApplied, l.FilterState())
+		rq.Equal([]list.Item{testItem("gamma")}, l.VisibleItems())
+
+		RemoveItem(&l, 0)
+		rq.Equal(list.FilterApplied, l.FilterState())
+		rq.Equal("gamma", l.FilterValue())
+		rq.Equal([]list.Item{testItem("gamma")}, l.VisibleItems())
+		rq.Equal([]list.Item{testItem("beta"), testItem("gamma")}, l.Items())
+	})
+
+	t.Run("filtered item", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		l := newList("gamma")
+		RemoveItem(&l, 2)
+		rq.Equal(list.FilterApplied, l.FilterState())
+		rq.Empty(l.VisibleItems())
+	})
+}
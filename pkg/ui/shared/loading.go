@@ -0,0 +1,120 @@
+package shared
+
+import (
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DefaultNamespace is opened when the context doesn't set the namespace, like kubectl does.
+const DefaultNamespace = "default"
+
+// NamespaceChangedMsg is sent when another namespace or all namespaces mode is chosen,
+// the namespaced components load their lists again.
+type NamespaceChangedMsg struct{}
+
+// ContextChangedMsg is sent when the client is switched to another context. All the lists are cleared,
+// namespaces are loaded again and `NamespaceChangedMsg` is sent then, cluster scoped components are loaded right away.
+type ContextChangedMsg struct{}
+
+// LoadErrorMsg is an error of the list loading. The list keeps the previous data, the error is shown
+// in the banner until it's dismissed.
+type LoadErrorMsg struct {
+	Tab TabItem
+	Err error
+}
+
+// NamespaceChanged is a command which reports the namespace change.
+func NamespaceChanged() tea.Msg {
+	return NamespaceChangedMsg{}
+}
+
+// ContextChanged is a command which reports the context switch.
+func ContextChanged() tea.Msg {
+	return ContextChangedMsg{}
+}
+
+// LoadError returns a command which reports the loading error of the tab list.
+func LoadError(tab TabItem, err error) tea.Cmd {
+	return func() tea.Msg {
+		return LoadErrorMsg{Tab: tab, Err: err}
+	}
+}
+
+// Loader keeps the loading state of the list, the spinner is shown in the table header while the list is loaded.
+// Every load has its generation: results of the outdated loads (of another namespace or context) are dropped.
+type Loader struct {
+	spinner    spinner.Model
+	loading    bool
+	generation int
+}
+
+func NewLoader(style lipgloss.Style) *Loader {
+	return &Loader{
+		spinner: spinner.New(spinner.WithSpinner(spinner.Dot), spinner.WithStyle(style)),
+	}
+}
+
+// Start marks the list as loading and returns the command which animates the spinner.
+// The loads started before are outdated, the result is checked by `Done` with the new `Generation`.
+func (l *Loader) Start() tea.Cmd {
+	l.generation++
+	l.loading = true
+
+	return l.spinner.Tick
+}
+
+// Generation returns the generation of the last started load, it's sent along with the load result.
+func (l *Loader) Generation() int {
+	return l.generation
+}
+
+// Done marks the list as loaded if the result belongs to the last started load.
+// It returns false for the result of the outdated load, it must be dropped.
+func (l *Loader) Done(generation int) bool {
+	if generation != l.generation {
+		return false
+	}
+	l.loading = false
+
+	return true
+}
+
+// Reset outdates the started loads without starting a new one. It's used on the context switch:
+// nothing loaded from the previous cluster must be shown.
+func (l *Loader) Reset() {
+	l.generation++
+	l.loading = false
+}
+
+// Stop marks the list as loaded, the spinner stops on the next tick.
+func (l *Loader) Stop() {
+	l.loading = false
+}
+
+// Loading returns true if the list is being loaded.
+func (l *Loader) Loading() bool {
+	return l.loading
+}
+
+// Update animates the spinner while the list is loaded. Ticks of other spinners are ignored.
+func (l *Loader) Update(msg tea.Msg) tea.Cmd {
+	tick, ok := msg.(spinner.TickMsg)
+	if !ok || !l.loading || tick.ID != l.spinner.ID() {
+		return nil
+	}
+
+	var cmd tea.Cmd
+	l.spinner, cmd = l.spinner.Update(tick)
+
+	return cmd
+}
+
+// View returns the spinner while the list is loaded and an empty string otherwise.
+func (l *Loader) View() string {
+	if !l.loading {
+		return ""
+	}
+
+	return l.spinner.View()
+}
@@ -0,0 +1,82 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Loader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("start and stop", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		l := NewLoader(lipgloss.NewStyle())
+		rq.False(l.Loading())
+		rq.Empty(l.View())
+
+		rq.NotNil(l.Start())
+		rq.True(l.Loading())
+		rq.NotEmpty(l.View())
+
+		l.Stop()
+		rq.False(l.Loading())
+		rq.Empty(l.View())
+	})
+
+	t.Run("own ticks only", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		l := NewLoader(lipgloss.NewStyle())
+		other := NewLoader(lipgloss.NewStyle())
+		l.Start()
+
+		rq.Nil(l.Update(other.spinner.Tick()))
+		rq.Nil(l.Update("not a tick"))
+		rq.NotNil(l.Update(l.spinner.Tick()))
+	})
+
+	t.Run("outdated load", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		l := NewLoader(lipgloss.NewStyle())
+		l.Start()
+		outdated := l.Generation()
+		l.Start()
+
+		rq.False(l.Done(outdated))
+		rq.True(l.Loading())
+		rq.True(l.Done(l.Generation()))
+		rq.False(l.Loading())
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		l := NewLoader(lipgloss.NewStyle())
+		l.Start()
+		started := l.Generation()
+		l.Reset()
+
+		rq.False(l.Loading())
+		rq.False(l.Done(started))
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		l := NewLoader(lipgloss.NewStyle())
+		tick := l.spinner.Tick()
+
+		rq.IsType(spinner.TickMsg{}, tick)
+		rq.Nil(l.Update(tick))
+	})
+}
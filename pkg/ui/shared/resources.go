@@ -0,0 +1,97 @@
+package shared
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+const (
+	mebibyte = 1 << 20
+	// NoValue is shown in place of the resource usage that isn't known.
+	NoValue = "-"
+	// MetricsUnavailable is shown in place of the resource usage when the cluster doesn't serve the metrics API.
+	MetricsUnavailable = "n/a"
+)
+
+// FormatCPU returns CPU in millicores like `kubectl top` does: 250m.
+func FormatCPU(milli int64) string {
+	return strconv.FormatInt(milli, 10) + "m"
+}
+
+// FormatMemory returns memory in mebibytes like `kubectl top` does: 64Mi.
+func FormatMemory(bytes int64) string {
+	return strconv.FormatInt(bytes/mebibyte, 10) + "Mi"
+}
+
+// FormatUsage returns the usage compared with the request and the limit: 250m (50% of 500m request).
+// Request and limit are skipped if they aren't set.
+func FormatUsage(usage, request, limit int64, format func(int64) string) string {
+	var s strings.Builder
+	s.WriteString(format(usage))
+
+	var shares []string
+	if request > 0 {
+		shares = append(shares, fmt.Sprintf("%d%% of %s request", usage*100/request, format(request)))
+	}
+	if limit > 0 {
+		shares = append(shares, fmt.Sprintf("%d%% of %s limit", usage*100/limit, format(limit)))
+	}
+	if len(shares) > 0 {
+		s.WriteString(" (")
+		s.WriteString(strings.Join(shares, ", "))
+		s.WriteString(")")
+	}
+
+	return s.String()
+}
+
+// RenderContainersUsage renders the usage of the containers against their requests and limits for the info bars.
+func RenderContainersUsage(cc []domain.Container, metrics *domain.PodMetrics, err error) string {
+	var info strings.Builder
+
+	info.WriteString(boldText.Render("Usage"))
+	info.WriteString("\n")
+
+	switch {
+	case err != nil:
+		info.WriteString(infoIndent)
+		info.WriteString(err.Error())
+		info.WriteString("\n")
+
+		return info.String()
+	case metrics == nil:
+		info.WriteString(infoIndent)
+		info.WriteString("no metrics yet")
+		info.WriteString("\n")
+
+		return info.String()
+	}
+
+	for i := range cc {
+		var usage domain.Resources
+		for j := range metrics.Containers {
+			if metrics.Containers[j].Name == cc[i].Name {
+				usage = metrics.Containers[j].Usage
+			}
+		}
+
+		info.WriteString(infoIndent)
+		info.WriteString(cc[i].Name)
+		info.WriteString("\n")
+		info.WriteString(infoIndent)
+		info.WriteString(infoIndent)
+		info.WriteString("CPU: ")
+		info.WriteString(FormatUsage(usage.CPU, cc[i].Requests.CPU, cc[i].Limits.CPU, FormatCPU))
+		info.WriteString("\n")
+		info.WriteString(infoIndent)
+		info.WriteString(infoIndent)
+		info.WriteString("Memory: ")
+		info.WriteString(FormatUsage(usage.Memory, cc[i].Requests.Memory, cc[i].Limits.Memory, FormatMemory))
+		info.WriteString("\n")
+	}
+
+	return info.String()
+}
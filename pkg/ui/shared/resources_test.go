@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tty2/kubic/pkg/domain"
+)
+
+func Test_FormatUsage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without request and limit", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "250m", FormatUsage(250, 0, 0, FormatCPU))
+	})
+	t.Run("request and limit", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "250m (50% of 500m request, 25% of 1000m limit)", FormatUsage(250, 500, 1000, FormatCPU))
+	})
+	t.Run("limit", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "96Mi (75% of 128Mi limit)", FormatUsage(96<<20, 0, 128<<20, FormatMemory))
+	})
+}
+
+func Test_RenderContainersUsage(t *testing.T) {
+	t.Parallel()
+
+	cc := []domain.Container{
+		{
+			Name:     "app",
+			Requests: domain.Resources{CPU: 100, Memory: 64 << 20},
+		},
+	}
+
+	t.Run("usage", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		info := RenderContainersUsage(cc, &domain.PodMetrics{
+			Containers: []domain.ContainerMetrics{
+				{Name: "app", Usage: domain.Resources{CPU: 50, Memory: 32 << 20}},
+			},
+		}, nil)
+		rq.Contains(info, "  app\n")
+		rq.Contains(info, "CPU: 50m (50% of 100m request)")
+		rq.Contains(info, "Memory: 32Mi (50% of 64Mi request)")
+	})
+
+	t.Run("metrics unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		info := RenderContainersUsage(cc, nil, domain.ErrMetricsUnavailable)
+		require.Contains(t, info, "metrics unavailable")
+	})
+}
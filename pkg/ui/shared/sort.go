@@ -0,0 +1,105 @@
+package shared
+
+import (
+	"sort"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+const (
+	sortAscSign  = "↑"
+	sortDescSign = "↓"
+)
+
+// SortColumn is the column the list can be sorted by.
+type SortColumn struct {
+	// Header is the title of the column in the table header.
+	Header string
+	// Less reports whether item a goes before item b in ascending order.
+	Less func(a, b list.Item) bool
+}
+
+// Sorter keeps sortable columns of the list and the current order. The list is sorted by the first column initially.
+type Sorter struct {
+	columns []SortColumn
+	column  int
+	desc    bool
+}
+
+func NewSorter(columns ...SortColumn) *Sorter {
+	return &Sorter{
+		columns: columns,
+	}
+}
+
+// NextColumn sorts by the next column in ascending order. The first column goes after the last one.
+func (s *Sorter) NextColumn() {
+	if len(s.columns) == 0 {
+		return
+	}
+
+	s.column = (s.column + 1) % len(s.columns)
+	s.desc = false
+}
+
+// Reverse changes the sort direction.
+func (s *Sorter) Reverse() {
+	s.desc = !s.desc
+}
+
+// Sort sorts items in place. Equal items keep their order.
+func (s *Sorter) Sort(items []list.Item) {
+	if len(s.columns) == 0 {
+		return
+	}
+
+	less := s.columns[s.column].Less
+	sort.SliceStable(items, func(i, j int) bool {
+		if s.desc {
+			return less(items[j], items[i])
+		}
+
+		return less(items[i], items[j])
+	})
+}
+
+// Title returns the column header with the sign of the sort direction if the list is sorted by the column.
+func (s *Sorter) Title(header string) string {
+	if len(s.columns) == 0 || s.columns[s.column].Header != header {
+		return header
+	}
+
+	if s.desc {
+		return header + sortDescSign
+	}
+
+	return header + sortAscSign
+}
+
+// SortList sorts the list items keeping the selected item under the cursor.
+func SortList(l *list.Model, s *Sorter) {
+	selected := l.SelectedItem()
+
+	items := make([]list.Item, len(l.Items()))
+	copy(items, l.Items())
+	s.Sort(items)
+	ApplyFilter(l, l.SetItems(items))
+
+	SelectItem(l, selected)
+}
+
+// SelectItem moves the cursor to the item if it's visible.
+func SelectItem(l *list.Model, item list.Item) {
+	if item == nil {
+		return
+	}
+
+	visible := l.VisibleItems()
+	for i := range visible {
+		if visible[i] == item {
+			l.Select(i)
+
+			return
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package shared
+
+import (
+	"io"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+)
+
+type sortItem struct {
+	name  string
+	count int
+}
+
+func (i *sortItem) FilterValue() string                                  { return i.name }
+func (i *sortItem) Height() int                                          { return 1 }
+func (i *sortItem) Spacing() int                                         { return 0 }
+func (i *sortItem) Update(msg tea.Msg, m *list.Model) tea.Cmd            { return nil }
+func (i *sortItem) Render(w io.Writer, m list.Model, _ int, _ list.Item) {}
+
+func newTestSorter() *Sorter {
+	return NewSorter(
+		SortColumn{
+			Header: "Name",
+			Less: func(a, b list.Item) bool {
+				return a.(*sortItem).name < b.(*sortItem).name
+			},
+		},
+		SortColumn{
+			Header: "Count",
+			Less: func(a, b list.Item) bool {
+				return a.(*sortItem).count < b.(*sortItem).count
+			},
+		},
+	)
+}
+
+func names(items []list.Item) []string {
+	res := make([]string, len(items))
+	for i := range items {
+		res[i] = items[i].(*sortItem).name
+	}
+
+	return res
+}
+
+func Test_Sorter(t *testing.T) {
+	t.Parallel()
+
+	newItems := func() []list.Item {
+		return []list.Item{
+			&sortItem{name: "b", count: 1},
+			&sortItem{name: "c", count: 3},
+			&sortItem{name: "a", count: 2},
+		}
+	}
+
+	t.Run("columns", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		s := newTestSorter()
+		items := newItems()
+
+		s.Sort(items)
+		rq.Equal([]string{"a", "b", "c"}, names(items))
+		rq.Equal("Name"+sortAscSign, s.Title("Name"))
+		rq.Equal("Count", s.Title("Count"))
+
+		s.Reverse()
+		s.Sort(items)
+		rq.Equal([]string{"c", "b", "a"}, names(items))
+		rq.Equal("Name"+sortDescSign, s.Title("Name"))
+
+		s.NextColumn()
+		s.Sort(items)
+		rq.Equal([]string{"b", "a", "c"}, names(items))
+		rq.Equal("Name", s.Title("Name"))
+		rq.Equal("Count"+sortAscSign, s.Title("Count"))
+
+		s.NextColumn()
+		rq.Equal("Name"+sortAscSign, s.Title("Name"))
+	})
+
+	t.Run("keep selection", func(t *testing.T) {
+		t.Parallel()
+		rq := require.New(t)
+
+		items := newItems()
+		l := list.New(items, &sortItem{}, 0, 10)
+		l.Select(1)
+
+		SortList(&l, newTestSorter())
+		rq.Equal([]string{"a", "b", "c"}, names(l.Items()))
+		rq.Equal(2, l.Index())
+		rq.Same(items[1], l.SelectedItem())
+	})
+}
@@ -5,18 +5,43 @@ package shared
 // TabItem, on the contrary, represents a concrete tab with a concrete title and related with a specific content.
 type TabItem int
 
+// SelectTabMsg opens the tab the same way as the tab keys do, it's handled by the tabs component.
+type SelectTabMsg struct {
+	Tab TabItem
+}
+
 // TabItem tabs.
 const (
 	NamespacesTab TabItem = iota
 	DeploymentsTab
 	PodsTab
+	ServicesTab
+	ConfigMapsTab
+	SecretsTab
+	StatefulSetsTab
+	DaemonSetsTab
+	CronJobsTab
+	NodesTab
+	EventsTab
+	PortForwardsTab
+	ContextsTab
 	AnyTab // used for elements that don't belong to any tab. As example, tabs themselves.
 )
 
 const (
-	namespacesTabTitle  = "Namespaces"
-	deploymentsTabTitle = "Deployments"
-	podsTabTitle        = "Pods"
+	namespacesTabTitle   = "Namespaces"
+	deploymentsTabTitle  = "Deployments"
+	podsTabTitle         = "Pods"
+	servicesTabTitle     = "Services"
+	configMapsTabTitle   = "ConfigMaps"
+	secretsTabTitle      = "Secrets"
+	statefulSetsTabTitle = "StatefulSets"
+	daemonSetsTabTitle   = "DaemonSets"
+	cronJobsTabTitle     = "CronJobs"
+	nodesTabTitle        = "Nodes"
+	eventsTabTitle       = "Events"
+	portForwardsTabTitle = "PortForwards"
+	contextsTabTitle     = "Contexts"
 )
 
 // String is a string representation of TabItems.
@@ -28,6 +53,26 @@ func (t TabItem) String() string {
 		return deploymentsTabTitle
 	case PodsTab:
 		return podsTabTitle
+	case ServicesTab:
+		return servicesTabTitle
+	case ConfigMapsTab:
+		return configMapsTabTitle
+	case SecretsTab:
+		return secretsTabTitle
+	case StatefulSetsTab:
+		return statefulSetsTabTitle
+	case DaemonSetsTab:
+		return daemonSetsTabTitle
+	case CronJobsTab:
+		return cronJobsTabTitle
+	case NodesTab:
+		return nodesTabTitle
+	case EventsTab:
+		return eventsTabTitle
+	case PortForwardsTab:
+		return portForwardsTabTitle
+	case ContextsTab:
+		return contextsTabTitle
 	default:
 		return ""
 	}
@@ -36,8 +81,18 @@ func (t TabItem) String() string {
 // GetTabItems returns the list of all available (visually) tabs.
 func GetTabItems() []TabItem {
 	return []TabItem{
+		ContextsTab,
 		NamespacesTab,
 		DeploymentsTab,
+		StatefulSetsTab,
+		DaemonSetsTab,
+		CronJobsTab,
 		PodsTab,
+		ServicesTab,
+		ConfigMapsTab,
+		SecretsTab,
+		EventsTab,
+		NodesTab,
+		PortForwardsTab,
 	}
 }
@@ -28,6 +28,66 @@ func Test_TabItem_String(t *testing.T) {
 		rq.Equal(podsTabTitle, PodsTab.String())
 	})
 
+	t.Run("services", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(servicesTabTitle, ServicesTab.String())
+	})
+
+	t.Run("configmaps", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(configMapsTabTitle, ConfigMapsTab.String())
+	})
+
+	t.Run("secrets", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(secretsTabTitle, SecretsTab.String())
+	})
+
+	t.Run("statefulsets", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(statefulSetsTabTitle, StatefulSetsTab.String())
+	})
+
+	t.Run("daemonsets", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(daemonSetsTabTitle, DaemonSetsTab.String())
+	})
+
+	t.Run("cronjobs", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(cronJobsTabTitle, CronJobsTab.String())
+	})
+
+	t.Run("nodes", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(nodesTabTitle, NodesTab.String())
+	})
+
+	t.Run("events", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(eventsTabTitle, EventsTab.String())
+	})
+
+	t.Run("port forwards", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(portForwardsTabTitle, PortForwardsTab.String())
+	})
+
+	t.Run("contexts", func(t *testing.T) {
+		t.Parallel()
+
+		rq.Equal(contextsTabTitle, ContextsTab.String())
+	})
+
 	t.Run("any", func(t *testing.T) {
 		t.Parallel()
 
@@ -44,9 +104,19 @@ func Test_GetTabItems(t *testing.T) {
 
 		tt := GetTabItems()
 
-		rq.Len(tt, 3)
-		rq.Equal(NamespacesTab, tt[0])
-		rq.Equal(DeploymentsTab, tt[1])
-		rq.Equal(PodsTab, tt[2])
+		rq.Len(tt, 13)
+		rq.Equal(ContextsTab, tt[0])
+		rq.Equal(NamespacesTab, tt[1])
+		rq.Equal(DeploymentsTab, tt[2])
+		rq.Equal(StatefulSetsTab, tt[3])
+		rq.Equal(DaemonSetsTab, tt[4])
+		rq.Equal(CronJobsTab, tt[5])
+		rq.Equal(PodsTab, tt[6])
+		rq.Equal(ServicesTab, tt[7])
+		rq.Equal(ConfigMapsTab, tt[8])
+		rq.Equal(SecretsTab, tt[9])
+		rq.Equal(EventsTab, tt[10])
+		rq.Equal(NodesTab, tt[11])
+		rq.Equal(PortForwardsTab, tt[12])
 	})
 }
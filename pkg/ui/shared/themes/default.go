@@ -26,6 +26,7 @@ type Styles struct {
 	MainText     lipgloss.Style
 	SelectedText lipgloss.Style
 	InactiveText lipgloss.Style
+	WarningText  lipgloss.Style
 	HelpBar      lipgloss.Style
 	// signs
 	NamespaceSign lipgloss.Style
@@ -52,6 +53,7 @@ type Theme struct {
 	MainText      lipgloss.Color `json:"main-text"`
 	SelectedText  lipgloss.Color `json:"selected-text"`
 	InactiveText  lipgloss.Color `json:"inactive-text"`
+	WarningText   lipgloss.Color `json:"warning-text"`
 	Borders       lipgloss.Color `json:"tab-borders"`
 	NamespaceSign lipgloss.Color `json:"namespace-sign"`
 }
@@ -63,6 +65,7 @@ var (
 		MainText:      lipgloss.Color("#E2E1ED"),
 		SelectedText:  lipgloss.Color("#EE6FF8"), // #AD58B4
 		InactiveText:  lipgloss.Color("#5C5C5C"),
+		WarningText:   lipgloss.Color("#F1A54B"),
 		Borders:       lipgloss.Color("#7D56F4"),
 		NamespaceSign: lipgloss.Color("#6aa84f"),
 	}
@@ -107,6 +110,7 @@ func GetStyle(theme Theme) Styles {
 		MainText:     lipgloss.NewStyle().Foreground(theme.MainText),
 		SelectedText: lipgloss.NewStyle().Foreground(theme.SelectedText),
 		InactiveText: lipgloss.NewStyle().Foreground(theme.InactiveText),
+		WarningText:  lipgloss.NewStyle().Foreground(theme.WarningText),
 		Borders:      lipgloss.NewStyle().Foreground(theme.Borders),
 		HelpBar: lipgloss.NewStyle().BorderForeground(theme.InactiveText).
 			BorderTop(true),
@@ -188,6 +192,9 @@ func initTheme(th Theme) Theme {
 	if validHexColor(string(th.InactiveText)) {
 		theme.InactiveText = th.InactiveText
 	}
+	if validHexColor(string(th.WarningText)) {
+		theme.WarningText = th.WarningText
+	}
 	if validHexColor(string(th.Borders)) {
 		theme.Borders = th.Borders
 	}
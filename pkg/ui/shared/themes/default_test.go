@@ -59,6 +59,7 @@ func Test_initTheme(t *testing.T) {
 		rq.Equal(defaultTheme.MainText, th.MainText)
 		rq.Equal(defaultTheme.SelectedText, th.SelectedText)
 		rq.Equal(defaultTheme.InactiveText, th.InactiveText)
+		rq.Equal(defaultTheme.WarningText, th.WarningText)
 		rq.Equal(defaultTheme.Borders, th.Borders)
 		rq.Equal(defaultTheme.NamespaceSign, th.NamespaceSign)
 	})
@@ -70,6 +71,7 @@ func Test_initTheme(t *testing.T) {
 			MainText:      lipgloss.Color("#000"),
 			SelectedText:  lipgloss.Color("#000"),
 			InactiveText:  lipgloss.Color("#000"),
+			WarningText:   lipgloss.Color("#000"),
 			Borders:       lipgloss.Color("#000"),
 			NamespaceSign: lipgloss.Color("#000"),
 		})
@@ -77,6 +79,7 @@ func Test_initTheme(t *testing.T) {
 		rq.NotEqual(defaultTheme.MainText, th.MainText)
 		rq.NotEqual(defaultTheme.SelectedText, th.SelectedText)
 		rq.NotEqual(defaultTheme.InactiveText, th.InactiveText)
+		rq.NotEqual(defaultTheme.WarningText, th.WarningText)
 		rq.NotEqual(defaultTheme.Borders, th.Borders)
 		rq.NotEqual(defaultTheme.NamespaceSign, th.NamespaceSign)
 	})
@@ -92,6 +95,7 @@ func Test_initTheme(t *testing.T) {
 		rq.NotEqual(defaultTheme.MainText, th.MainText)
 		rq.Equal(defaultTheme.SelectedText, th.SelectedText)
 		rq.NotEqual(defaultTheme.InactiveText, th.InactiveText)
+		rq.Equal(defaultTheme.WarningText, th.WarningText)
 		rq.Equal(defaultTheme.Borders, th.Borders)
 		rq.Equal(defaultTheme.NamespaceSign, th.NamespaceSign)
 	})
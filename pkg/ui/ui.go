@@ -1,17 +1,32 @@
 package ui
 
 import (
+	"fmt"
 	"os"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/tty2/kubic/pkg/config"
 	"github.com/tty2/kubic/pkg/k8s"
+	"github.com/tty2/kubic/pkg/ui/components/banner"
+	"github.com/tty2/kubic/pkg/ui/components/cmdline"
+	"github.com/tty2/kubic/pkg/ui/components/configmaps"
+	"github.com/tty2/kubic/pkg/ui/components/contexts"
+	"github.com/tty2/kubic/pkg/ui/components/cronjobs"
+	"github.com/tty2/kubic/pkg/ui/components/daemonsets"
 	"github.com/tty2/kubic/pkg/ui/components/deployments"
+	"github.com/tty2/kubic/pkg/ui/components/events"
 	"github.com/tty2/kubic/pkg/ui/components/help"
 	"github.com/tty2/kubic/pkg/ui/components/namespaces"
+	"github.com/tty2/kubic/pkg/ui/components/nodes"
 	"github.com/tty2/kubic/pkg/ui/components/pods"
+	"github.com/tty2/kubic/pkg/ui/components/portforwards"
+	"github.com/tty2/kubic/pkg/ui/components/secrets"
+	"github.com/tty2/kubic/pkg/ui/components/services"
+	"github.com/tty2/kubic/pkg/ui/components/statefulsets"
 	"github.com/tty2/kubic/pkg/ui/components/tabs"
 	"github.com/tty2/kubic/pkg/ui/shared"
 	"github.com/tty2/kubic/pkg/ui/shared/themes"
@@ -19,11 +34,43 @@ import (
 )
 
 type components struct {
-	tabs        tea.Model
-	namespaces  tea.Model
-	deployments tea.Model
-	pods        tea.Model
-	help        tea.Model
+	tabs         tea.Model
+	contexts     tea.Model
+	namespaces   tea.Model
+	deployments  tea.Model
+	statefulSets tea.Model
+	daemonSets   tea.Model
+	cronJobs     tea.Model
+	pods         tea.Model
+	services     tea.Model
+	configMaps   tea.Model
+	secrets      tea.Model
+	nodes        tea.Model
+	events       tea.Model
+	portForwards tea.Model
+	help         tea.Model
+	cmdline      *cmdline.Model
+	banner       *banner.Model
+}
+
+// filterable is a component with a filtered list.
+type filterable interface {
+	FilterState() list.FilterState
+}
+
+// dialoger is a component which can open a dialog, while it's open all the keys belong to the dialog.
+type dialoger interface {
+	DialogOpen() bool
+}
+
+// commander is a component which runs the commands typed in the command line.
+type commander interface {
+	RunCommand(cmd shared.Command) (tea.Cmd, error)
+}
+
+// completer is a component which completes the argument of its resource command, like `:ns kube-system`.
+type completer interface {
+	CommandArgs() []string
 }
 
 type MainModel struct {
@@ -31,16 +78,24 @@ type MainModel struct {
 	app        *shared.App
 }
 
-func New(k8sClient *k8s.Client, theme themes.Theme) (tea.Model, error) {
+func New(k8sClient *k8s.Client, theme themes.Theme, cfg config.Config) (tea.Model, error) {
 	var err error
 	app := shared.NewApp(theme)
 	model := MainModel{
 		app: app,
 		components: components{
-			tabs: tabs.New(app, shared.GetTabItems()),
-			help: help.New(app),
+			tabs:   tabs.New(app, shared.GetTabItems()),
+			help:   help.New(app),
+			banner: banner.New(app),
 		},
 	}
+	model.components.cmdline = cmdline.New(app, model.runCommand, model.commandArgs)
+
+	kc, err := contexts.New(app, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	model.components.contexts = kc
 
 	ns, err := namespaces.New(app, k8sClient)
 	if err != nil {
@@ -54,12 +109,66 @@ func New(k8sClient *k8s.Client, theme themes.Theme) (tea.Model, error) {
 	}
 	model.components.deployments = dep
 
+	sts, err := statefulsets.New(app, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	model.components.statefulSets = sts
+
+	ds, err := daemonsets.New(app, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	model.components.daemonSets = ds
+
+	cj, err := cronjobs.New(app, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	model.components.cronJobs = cj
+
 	pod, err := pods.New(app, k8sClient)
 	if err != nil {
 		return nil, err
 	}
 	model.components.pods = pod
 
+	svc, err := services.New(app, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	model.components.services = svc
+
+	cms, err := configmaps.New(app, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	model.components.configMaps = cms
+
+	sec, err := secrets.New(app, k8sClient, cfg.NoSecretReveal)
+	if err != nil {
+		return nil, err
+	}
+	model.components.secrets = sec
+
+	nd, err := nodes.New(app, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	model.components.nodes = nd
+
+	ev, err := events.New(app, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	model.components.events = ev
+
+	pf, err := portforwards.New(app, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	model.components.portForwards = pf
+
 	model.app.GUI.ScreenWidth, model.app.GUI.ScreenHeight, err = term.GetSize(int(os.Stdout.Fd()))
 	if err != nil {
 		return nil, err
@@ -69,8 +178,14 @@ func New(k8sClient *k8s.Client, theme themes.Theme) (tea.Model, error) {
 	return &model, nil
 }
 
+// Init starts loading of all the lists, they are shown with spinners until the results come.
 func (model *MainModel) Init() tea.Cmd {
-	return nil
+	var cmds []tea.Cmd
+	for _, c := range model.listComponents() {
+		cmds = append(cmds, c.Init())
+	}
+
+	return tea.Batch(cmds...)
 }
 
 func (model *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -84,6 +199,19 @@ func (model *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd = model.keyEventHandle(msg)
 	case tea.WindowSizeMsg:
 		model.onWindowSizeChanged(msg)
+	case list.FilterMatchesMsg:
+		// only the current component can be filtered by user
+		cmd = model.componentsKeyEventHandle(msg)
+	case shared.ShowPodsMsg:
+		model.app.CurrentTab = shared.PodsTab
+		cmd = model.componentsMsgHandle(msg)
+	case shared.BackMsg:
+		model.app.CurrentTab = msg.Owner.Tab
+		cmd = model.componentsMsgHandle(msg)
+	case shared.LoadErrorMsg:
+		_, cmd = model.components.banner.Update(msg)
+	default:
+		cmd = model.componentsMsgHandle(msg)
 	}
 	cmds = append(cmds, cmd)
 
@@ -97,28 +225,54 @@ func (model *MainModel) View() string {
 	s.WriteString(model.components.tabs.View())
 	s.WriteString("\n")
 
+	// error banner
+	if model.components.banner.Shown() {
+		s.WriteString(model.components.banner.View())
+		s.WriteString("\n")
+	}
+
 	// content
-	switch model.app.CurrentTab {
-	case shared.NamespacesTab:
-		s.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, model.components.namespaces.View()))
-	case shared.DeploymentsTab:
-		s.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, model.components.deployments.View()))
-	case shared.PodsTab:
-		s.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, model.components.pods.View()))
+	if c := model.currentComponent(); c != nil {
+		s.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, c.View()))
 	}
 
-	// help
+	// help or command line
+	bottom := model.components.help.View()
+	if model.components.cmdline.IsOpen() {
+		bottom = model.components.cmdline.View()
+	}
 	s.WriteString(lipgloss.PlaceVertical(
 		model.app.GUI.Areas.HelpBar.Height,
-		lipgloss.Bottom, model.components.help.View()))
+		lipgloss.Bottom, bottom))
 
 	return s.String()
 }
 
 func (model *MainModel) keyEventHandle(msg tea.KeyMsg) tea.Cmd {
+	filterState := model.currentFilterState()
+
 	switch {
+	case model.components.cmdline.IsOpen() && msg.Type != tea.KeyCtrlC:
+		_, cmd := model.components.cmdline.Update(msg)
+
+		return cmd
+	case model.currentDialogOpen() && msg.Type != tea.KeyCtrlC:
+		return model.componentsKeyEventHandle(msg)
+	case filterState == list.Filtering && msg.Type != tea.KeyCtrlC:
+		// user types filter, all the keys belong to the filter input
+		return model.componentsKeyEventHandle(msg)
+	case filterState == list.FilterApplied && key.Matches(msg, model.app.KeyMap.ClearFilter):
+		return model.componentsKeyEventHandle(msg)
+	case model.components.banner.Shown() && key.Matches(msg, model.app.KeyMap.DismissError):
+		_, cmd := model.components.banner.Update(msg)
+
+		return cmd
 	case key.Matches(msg, model.app.KeyMap.Quit):
 		return tea.Quit
+	case key.Matches(msg, model.app.KeyMap.Command):
+		model.components.cmdline.Open()
+
+		return nil
 	case key.Matches(msg, model.app.KeyMap.Help):
 		model.components.help.Update(msg)
 
@@ -132,20 +286,147 @@ func (model *MainModel) keyEventHandle(msg tea.KeyMsg) tea.Cmd {
 	}
 }
 
-func (model *MainModel) componentsKeyEventHandle(msg tea.KeyMsg) tea.Cmd {
-	var cmd tea.Cmd
-	switch model.app.CurrentTab {
+// currentComponent returns the component of the current tab.
+func (model *MainModel) currentComponent() tea.Model {
+	return model.componentOf(model.app.CurrentTab)
+}
+
+// componentOf returns the component of the tab.
+func (model *MainModel) componentOf(tab shared.TabItem) tea.Model {
+	switch tab {
+	case shared.ContextsTab:
+		return model.components.contexts
 	case shared.NamespacesTab:
-		_, cmd = model.components.namespaces.Update(msg)
+		return model.components.namespaces
 	case shared.DeploymentsTab:
-		_, cmd = model.components.deployments.Update(msg)
+		return model.components.deployments
+	case shared.StatefulSetsTab:
+		return model.components.statefulSets
+	case shared.DaemonSetsTab:
+		return model.components.daemonSets
+	case shared.CronJobsTab:
+		return model.components.cronJobs
 	case shared.PodsTab:
-		_, cmd = model.components.pods.Update(msg)
+		return model.components.pods
+	case shared.ServicesTab:
+		return model.components.services
+	case shared.ConfigMapsTab:
+		return model.components.configMaps
+	case shared.SecretsTab:
+		return model.components.secrets
+	case shared.NodesTab:
+		return model.components.nodes
+	case shared.EventsTab:
+		return model.components.events
+	case shared.PortForwardsTab:
+		return model.components.portForwards
+	default:
+		return nil
+	}
+}
+
+// runCommand runs the command typed in the command line. Resource commands jump to the tab,
+// their argument (like the namespace name) and the actions are run by the component.
+func (model *MainModel) runCommand(cmd shared.Command) (tea.Cmd, error) {
+	if cmd.Name == shared.QuitCommand {
+		return tea.Quit, nil
+	}
+
+	tab, isResource := cmd.Tab()
+	if isResource && len(cmd.Args) == 0 {
+		model.components.tabs.Update(shared.SelectTabMsg{Tab: tab})
+
+		return nil, nil
+	}
+
+	target := model.currentComponent()
+	if isResource {
+		target = model.componentOf(tab)
+	}
+
+	c, ok := target.(commander)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", shared.ErrCommandNotAvailable, cmd.Name)
 	}
 
+	teaCmd, err := c.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if isResource {
+		model.components.tabs.Update(shared.SelectTabMsg{Tab: tab})
+	}
+
+	return teaCmd, nil
+}
+
+// commandArgs returns the values to complete the argument of the resource command.
+func (model *MainModel) commandArgs(tab shared.TabItem) []string {
+	if c, ok := model.componentOf(tab).(completer); ok {
+		return c.CommandArgs()
+	}
+
+	return nil
+}
+
+func (model *MainModel) currentFilterState() list.FilterState {
+	if f, ok := model.currentComponent().(filterable); ok {
+		return f.FilterState()
+	}
+
+	return list.Unfiltered
+}
+
+func (model *MainModel) currentDialogOpen() bool {
+	if d, ok := model.currentComponent().(dialoger); ok {
+		return d.DialogOpen()
+	}
+
+	return false
+}
+
+func (model *MainModel) componentsKeyEventHandle(msg tea.Msg) tea.Cmd {
+	c := model.currentComponent()
+	if c == nil {
+		return nil
+	}
+
+	_, cmd := c.Update(msg)
+
 	return cmd
 }
 
+// componentsMsgHandle passes non key messages (like resources changes and loading results) to all the components,
+// because they must be up to date even if they are not shown currently.
+func (model *MainModel) componentsMsgHandle(msg tea.Msg) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, c := range model.listComponents() {
+		_, cmd := c.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// listComponents returns the components of all the tabs.
+func (model *MainModel) listComponents() []tea.Model {
+	return []tea.Model{
+		model.components.contexts,
+		model.components.namespaces,
+		model.components.deployments,
+		model.components.statefulSets,
+		model.components.daemonSets,
+		model.components.cronJobs,
+		model.components.pods,
+		model.components.services,
+		model.components.configMaps,
+		model.components.secrets,
+		model.components.events,
+		model.components.nodes,
+		model.components.portForwards,
+	}
+}
+
 func (model *MainModel) onWindowSizeChanged(msg tea.WindowSizeMsg) {
 	model.app.GUI.ScreenWidth = msg.Width
 	model.app.GUI.ScreenHeight = msg.Height